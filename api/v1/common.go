@@ -16,6 +16,10 @@
 
 package v1
 
+import (
+	corev1 "k8s.io/api/core/v1"
+)
+
 type DriverPhase string
 
 const (
@@ -24,3 +28,142 @@ const (
 	DriverPhaseRunning  DriverPhase = "Running"
 	DriverPhaseFailed   DriverPhase = "Failed"
 )
+
+// ManagementState defines whether the operator manages a component
+// +kubebuilder:validation:Enum=Managed;Unmanaged;Removed
+type ManagementState string
+
+const (
+	// ManagementStateManaged means the operator creates the component and reverts any change to it
+	ManagementStateManaged ManagementState = "Managed"
+	// ManagementStateUnmanaged means the operator leaves the component as is
+	ManagementStateUnmanaged ManagementState = "Unmanaged"
+	// ManagementStateRemoved means the operator deletes the component
+	ManagementStateRemoved ManagementState = "Removed"
+)
+
+// SidecarEnabled defines whether the operator deploys a sidecar
+// +kubebuilder:validation:Enum=auto;"true";"false"
+type SidecarEnabled string
+
+const (
+	// SidecarEnabledAuto means the operator deploys the sidecar when the cluster serves the APIs it needs
+	SidecarEnabledAuto SidecarEnabled = "auto"
+	// SidecarEnabledTrue means the operator deploys the sidecar
+	SidecarEnabledTrue SidecarEnabled = "true"
+	// SidecarEnabledFalse means the operator does not deploy the sidecar
+	SidecarEnabledFalse SidecarEnabled = "false"
+)
+
+// ConnectivityType is a connectivity type between the nodes and the storage arrays
+// +kubebuilder:validation:Enum=nvmeofc;nvmeotcp;fc;iscsi
+type ConnectivityType string
+
+const (
+	ConnectivityTypeNVMeOverFC  ConnectivityType = "nvmeofc"
+	ConnectivityTypeNVMeOverTCP ConnectivityType = "nvmeotcp"
+	ConnectivityTypeFC          ConnectivityType = "fc"
+	ConnectivityTypeISCSI       ConnectivityType = "iscsi"
+)
+
+// ComponentVersion defines an image a container of a component runs, as observed in its pods
+type ComponentVersion struct {
+	// Component is the component the pods belong to
+	Component string `json:"component"`
+
+	// Container is the name of the container in the pods
+	Container string `json:"container"`
+
+	// Image is the image of the container in the pod spec
+	Image string `json:"image"`
+
+	// ImageID is the image digest the container runs
+	// +optional
+	ImageID string `json:"imageID,omitempty"`
+
+	// Version is the tag of the image
+	// +optional
+	Version string `json:"version,omitempty"`
+
+	// Pods is the number of pods which run the image
+	Pods int32 `json:"pods"`
+}
+
+// ProxyConfig defines an HTTP(S) proxy
+type ProxyConfig struct {
+	// +kubebuilder:validation:Optional
+	HTTPProxy string `json:"httpProxy,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	HTTPSProxy string `json:"httpsProxy,omitempty"`
+
+	// NoProxy is a comma-separated list of the hosts and domains which are not proxied
+	// +kubebuilder:validation:Optional
+	NoProxy string `json:"noProxy,omitempty"`
+}
+
+// TrustedCA defines the CA bundle trusted by the containers of a component,
+// which is appended to the CA bundle of their image
+type TrustedCA struct {
+	// ConfigMap is the name of a ConfigMap in the namespace of the custom resource,
+	// with the CA bundle under the ca-bundle.crt key
+	// +kubebuilder:validation:Optional
+	ConfigMap string `json:"configMap,omitempty"`
+
+	// InjectClusterBundle generates a ConfigMap which OpenShift injects the trusted CA bundle of the cluster into,
+	// it cannot be set with configMap
+	// +kubebuilder:validation:Optional
+	InjectClusterBundle bool `json:"injectClusterBundle,omitempty"`
+}
+
+// SecurityContext overrides the restricted security context which the operator generates for the pods of a component,
+// the fields which are not set take the operator defaults
+type SecurityContext struct {
+	// RunAsUser is the user the containers run as
+	// +kubebuilder:validation:Optional
+	RunAsUser *int64 `json:"runAsUser,omitempty"`
+
+	// RunAsGroup is the primary group the containers run as
+	// +kubebuilder:validation:Optional
+	RunAsGroup *int64 `json:"runAsGroup,omitempty"`
+
+	// FSGroup is the group which owns the volumes of the pods
+	// +kubebuilder:validation:Optional
+	FSGroup *int64 `json:"fsGroup,omitempty"`
+
+	// ReadOnlyRootFilesystem mounts the root filesystem of the containers read-only, the default is true
+	// +kubebuilder:validation:Optional
+	ReadOnlyRootFilesystem *bool `json:"readOnlyRootFilesystem,omitempty"`
+
+	// SeccompProfile is the seccomp profile of the pods, the default is RuntimeDefault
+	// +kubebuilder:validation:Optional
+	SeccompProfile *corev1.SeccompProfile `json:"seccompProfile,omitempty"`
+}
+
+// NetworkPolicy defines the NetworkPolicy generated for the pods of a component
+type NetworkPolicy struct {
+	// Enabled generates a NetworkPolicy which allows the ingress to the health port of the pods,
+	// and the egress to the cluster DNS, to the Kubernetes API, to the proxy and to the storage arrays
+	Enabled bool `json:"enabled"`
+
+	// ArrayCIDRs are the CIDRs of the management addresses of the storage arrays, in addition
+	// to the IP addresses in the Secrets of the storage classes of the driver
+	// +kubebuilder:validation:Optional
+	ArrayCIDRs []string `json:"arrayCIDRs,omitempty"`
+
+	// MetricsPorts are the metrics ports of the pods the ingress is allowed to, e.g. ports added by overrides
+	// +kubebuilder:validation:Optional
+	MetricsPorts []int32 `json:"metricsPorts,omitempty"`
+}
+
+// ImagePullSecretSource defines a pull secret of another namespace, which the operator copies into the namespace
+// of the custom resource as <name of the custom resource>-<namespace>.<name>, keeps in sync and attaches to the
+// ServiceAccounts. The Secret must be of the kubernetes.io/dockerconfigjson or kubernetes.io/dockercfg type,
+// with the csi.ibm.com/image-pull-secret-shareable=true label
+type ImagePullSecretSource struct {
+	// Name is the name of the Secret
+	Name string `json:"name"`
+
+	// Namespace is the namespace of the Secret
+	Namespace string `json:"namespace"`
+}
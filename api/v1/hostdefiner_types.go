@@ -19,6 +19,7 @@ package v1
 import (
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 )
 
 // EDIT THIS FILE!  THIS IS SCAFFOLDING FOR YOU TO OWN!
@@ -29,6 +30,57 @@ type HostDefinerSpec struct {
 	HostDefiner IBMBlockHostDefinerSpec `json:"hostDefiner"`
 
 	ImagePullSecrets []string `json:"imagePullSecrets,omitempty"`
+
+	// ImagePullSecretSources are pull secrets of other namespaces, attached to the ServiceAccount
+	// with imagePullSecrets
+	// +kubebuilder:validation:Optional
+	ImagePullSecretSources []ImagePullSecretSource `json:"imagePullSecretSources,omitempty"`
+
+	// TrustedCA is the CA bundle the host definer trusts
+	// +kubebuilder:validation:Optional
+	TrustedCA *TrustedCA `json:"trustedCA,omitempty"`
+
+	// NetworkPolicy generates a NetworkPolicy for the host definer pods
+	// +kubebuilder:validation:Optional
+	NetworkPolicy *NetworkPolicy `json:"networkPolicy,omitempty"`
+
+	// IOGroupPlacement places the hosts of the nodes without IO group labels in IO groups,
+	// by labelling the nodes before the host definer defines their hosts
+	// +kubebuilder:validation:Optional
+	IOGroupPlacement *IOGroupPlacement `json:"ioGroupPlacement,omitempty"`
+}
+
+// IOGroupPlacementStrategy is how the hosts of the nodes are placed in IO groups
+// +kubebuilder:validation:Enum=Fixed;RoundRobin;ZoneAligned;LabelMapping
+type IOGroupPlacementStrategy string
+
+const (
+	// IOGroupPlacementFixed places all the hosts in ioGroups
+	IOGroupPlacementFixed IOGroupPlacementStrategy = "Fixed"
+	// IOGroupPlacementRoundRobin places each host in the IO group of ioGroups with the fewest nodes
+	IOGroupPlacementRoundRobin IOGroupPlacementStrategy = "RoundRobin"
+	// IOGroupPlacementZoneAligned places the hosts in the IO groups which mapping maps the zone of their node to
+	IOGroupPlacementZoneAligned IOGroupPlacementStrategy = "ZoneAligned"
+	// IOGroupPlacementLabelMapping places the hosts in the IO groups which mapping maps the label of their node to
+	IOGroupPlacementLabelMapping IOGroupPlacementStrategy = "LabelMapping"
+)
+
+// IOGroupPlacement defines how the hosts of the nodes are placed in IO groups
+type IOGroupPlacement struct {
+	Strategy IOGroupPlacementStrategy `json:"strategy"`
+
+	// IOGroups are the IO groups of Fixed and RoundRobin
+	// +kubebuilder:validation:Optional
+	IOGroups []int `json:"ioGroups,omitempty"`
+
+	// Label is the node label of LabelMapping
+	// +kubebuilder:validation:Optional
+	Label string `json:"label,omitempty"`
+
+	// Mapping maps the topology.kubernetes.io/zone label of the nodes with ZoneAligned,
+	// or the values of label with LabelMapping, to IO groups
+	// +kubebuilder:validation:Optional
+	Mapping map[string][]int `json:"mapping,omitempty"`
 }
 
 // IBMBlockHostDefinerSpec defines the observed state of HostDefiner
@@ -54,6 +106,19 @@ type IBMBlockHostDefinerSpec struct {
 	DynamicNodeLabeling bool `json:"dynamicNodeLabeling,omitempty"`
 	// +kubebuilder:validation:Optional
 	PortSet string `json:"portSet"`
+
+	// ManagementState is the management state of the host definer, the default is Managed
+	// +kubebuilder:validation:Optional
+	ManagementState ManagementState `json:"managementState,omitempty"`
+
+	// Overrides is a strategic merge patch applied on the generated pod template
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:pruning:PreserveUnknownFields
+	Overrides *runtime.RawExtension `json:"overrides,omitempty"`
+
+	// SecurityContext overrides the restricted security context of the host definer pods
+	// +kubebuilder:validation:Optional
+	SecurityContext *SecurityContext `json:"securityContext,omitempty"`
 }
 
 // HostDefinerStatus defines the observed state of HostDefiner
@@ -61,8 +126,54 @@ type HostDefinerStatus struct {
 	Phase            DriverPhase `json:"phase"`
 	HostDefinerReady bool        `json:"hostDefinerReady"`
 
-	// Version is the current driver version
+	// Version is the version the host definer pods run,
+	// empty while they run different versions
 	Version string `json:"version"`
+
+	// ComponentVersions are the images the containers of the host definer pods run
+	// +optional
+	ComponentVersions []ComponentVersion `json:"componentVersions,omitempty"`
+
+	// Paused is true when reconciliation is paused by the pause annotation
+	Paused bool `json:"paused,omitempty"`
+
+	// HostDefinerManagementState is the management state applied to the host definer
+	HostDefinerManagementState ManagementState `json:"hostDefinerManagementState,omitempty"`
+
+	// HostDefinerOverridesHash is the hash of the overrides applied on the host definer pod template
+	HostDefinerOverridesHash string `json:"hostDefinerOverridesHash,omitempty"`
+
+	// MissingImagePullSecrets are the pull secrets of imagePullSecrets and imagePullSecretSources which do not exist
+	// +optional
+	MissingImagePullSecrets []string `json:"missingImagePullSecrets,omitempty"`
+
+	// InvalidImagePullSecrets are the pull secrets of imagePullSecretSources which are not copied,
+	// as they are not pull secrets or do not opt in to be copied
+	// +optional
+	InvalidImagePullSecrets []string `json:"invalidImagePullSecrets,omitempty"`
+
+	// InvalidArraySecrets are the labelled storage array Secrets whose schema is invalid
+	// +optional
+	InvalidArraySecrets []InvalidArraySecret `json:"invalidArraySecrets,omitempty"`
+
+	// IOGroupDistribution is the number of nodes whose hosts are in each IO group, by the IO group labels of the nodes
+	// +optional
+	IOGroupDistribution []IOGroupNodes `json:"ioGroupDistribution,omitempty"`
+}
+
+// IOGroupNodes defines the number of nodes whose hosts are in an IO group
+type IOGroupNodes struct {
+	IOGroup int   `json:"ioGroup"`
+	Nodes   int32 `json:"nodes"`
+}
+
+// InvalidArraySecret defines a storage array Secret whose schema is invalid
+type InvalidArraySecret struct {
+	Name      string `json:"name"`
+	Namespace string `json:"namespace"`
+
+	// Message tells why the Secret is invalid
+	Message string `json:"message"`
 }
 
 //+kubebuilder:object:root=true
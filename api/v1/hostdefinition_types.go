@@ -29,6 +29,7 @@ type HostDefinitionSpec struct {
 }
 
 // Definition defines the observed state of HostDefinition
+// +kubebuilder:validation:XValidation:rule="!has(self.ports) || !has(self.connectivityType) || !(self.connectivityType in ['nvmeofc', 'nvmeotcp']) || self.ports.all(port, port.startsWith('nqn.'))",message="the ports of nvmeofc and nvmeotcp must be NVMe host NQNs"
 type Definition struct {
 	NodeName          string `json:"nodeName"`
 	ManagementAddress string `json:"managementAddress"`
@@ -41,12 +42,16 @@ type Definition struct {
 	NodeId string `json:"nodeId"`
 	// +kubebuilder:validation:Optional
 	ConnectivityType string `json:"connectivityType"`
+	// Ports are the initiator ports of the node by the connectivity type,
+	// FC WWPNs, iSCSI IQNs or the NVMe host NQN for nvmeofc and nvmeotcp
 	// +kubebuilder:validation:Optional
 	Ports []string `json:"ports"`
 	// +kubebuilder:validation:Optional
 	NodeNameOnStorage string `json:"nodeNameOnStorage"`
 	// +kubebuilder:validation:Optional
 	IOGroups []int `json:"ioGroups"`
+	// +kubebuilder:validation:Optional
+	PortSet string `json:"portSet"`
 }
 
 // HostDefinitionStatus defines the status of the host definition on the storage
@@ -18,7 +18,9 @@ package v1
 
 import (
 	corev1 "k8s.io/api/core/v1"
+	storagev1 "k8s.io/api/storage/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 )
 
 type CSISidecar struct {
@@ -34,6 +36,11 @@ type CSISidecar struct {
 	// The pullPolicy of the csi sidecar image
 	// +kubebuilder:validation:Optional
 	ImagePullPolicy corev1.PullPolicy `json:"imagePullPolicy"`
+
+	// Enabled defines whether the controller deploys the sidecar, auto by default.
+	// The livenessprobe and csi-node-driver-registrar sidecars are always deployed.
+	// +kubebuilder:validation:Optional
+	Enabled SidecarEnabled `json:"enabled,omitempty"`
 }
 
 // NOTE: json tags are required.  Any new fields you add must have json tags for the fields to be serialized.
@@ -49,8 +56,14 @@ type IBMBlockCSISpec struct {
 	// +kubebuilder:validation:Optional
 	ImagePullSecrets []string `json:"imagePullSecrets,omitempty"`
 
+	// ImagePullSecretSources are pull secrets of other namespaces, attached to the ServiceAccounts
+	// with imagePullSecrets
+	// +kubebuilder:validation:Optional
+	ImagePullSecretSources []ImagePullSecretSource `json:"imagePullSecretSources,omitempty"`
+
 	HealthPort uint16 `json:"healthPort,omitempty"`
 
+	// EnableCallHome is deprecated, use CallHome
 	// +kubebuilder:validation:Optional
 	EnableCallHome string `json:"enableCallHome,omitempty"`
 
@@ -59,6 +72,162 @@ type IBMBlockCSISpec struct {
 
 	// +kubebuilder:validation:Optional
 	SvcSshPort uint16 `json:"svcSshPort"`
+
+	// ManagementState is the default management state of the controller and the node,
+	// the default is Managed
+	// +kubebuilder:validation:Optional
+	ManagementState ManagementState `json:"managementState,omitempty"`
+
+	// HostPrerequisites configures the hosts with a MachineConfig per role on OpenShift,
+	// no MachineConfig is generated when it is not set
+	// +kubebuilder:validation:Optional
+	HostPrerequisites *HostPrerequisites `json:"hostPrerequisites,omitempty"`
+
+	// CapacityTracking publishes the capacity of the storage pools for the scheduler
+	// +kubebuilder:validation:Optional
+	CapacityTracking *CapacityTracking `json:"capacityTracking,omitempty"`
+
+	// HealthMonitor configures the csi-external-health-monitor-controller sidecar
+	// +kubebuilder:validation:Optional
+	HealthMonitor *HealthMonitor `json:"healthMonitor,omitempty"`
+
+	// CSIDriver configures the fields of the CSIDriver object of the driver,
+	// the fields which are not set take the Kubernetes defaults
+	// +kubebuilder:validation:Optional
+	CSIDriver *CSIDriverOptions `json:"csiDriver,omitempty"`
+
+	// CallHome configures the call home of the controller plugin, it replaces enableCallHome
+	// +kubebuilder:validation:Optional
+	CallHome *CallHome `json:"callHome,omitempty"`
+
+	// TrustedCA is the CA bundle the controller and the node plugins trust
+	// +kubebuilder:validation:Optional
+	TrustedCA *TrustedCA `json:"trustedCA,omitempty"`
+
+	// NetworkPolicy generates a NetworkPolicy for the controller pods
+	// +kubebuilder:validation:Optional
+	NetworkPolicy *NetworkPolicy `json:"networkPolicy,omitempty"`
+
+	// LabelNamespaceForPodSecurity labels the namespace of the IBMBlockCSI with the privileged level
+	// of Pod Security admission, which the node plugin pods need, it adds only the labels the namespace does not have
+	// +kubebuilder:validation:Optional
+	LabelNamespaceForPodSecurity bool `json:"labelNamespaceForPodSecurity,omitempty"`
+}
+
+// CallHome defines the call home of the controller plugin
+type CallHome struct {
+	Enabled bool `json:"enabled"`
+
+	// Proxy is the proxy of the controller plugin, which it calls home through,
+	// the cluster-wide proxy when it is not set
+	// +kubebuilder:validation:Optional
+	Proxy *ProxyConfig `json:"proxy,omitempty"`
+}
+
+// CSIDriverOptions defines the configurable fields of the CSIDriver object,
+// see the CSIDriverSpec of the storage.k8s.io API for their meaning
+type CSIDriverOptions struct {
+	// +kubebuilder:validation:Optional
+	PodInfoOnMount *bool `json:"podInfoOnMount,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Enum=ReadWriteOnceWithFSType;File;None
+	FSGroupPolicy *storagev1.FSGroupPolicy `json:"fsGroupPolicy,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	VolumeLifecycleModes []storagev1.VolumeLifecycleMode `json:"volumeLifecycleModes,omitempty"`
+
+	// SELinuxMount overrides the seLinuxMount feature of the Kubernetes version
+	// +kubebuilder:validation:Optional
+	SELinuxMount *bool `json:"seLinuxMount,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	RequiresRepublish *bool `json:"requiresRepublish,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	TokenRequests []storagev1.TokenRequest `json:"tokenRequests,omitempty"`
+}
+
+// HealthMonitor defines the csi-external-health-monitor-controller sidecar
+type HealthMonitor struct {
+	// EnableNodeWatcher reports the failures of the nodes the pods which use a volume run on
+	// +kubebuilder:validation:Optional
+	EnableNodeWatcher bool `json:"enableNodeWatcher,omitempty"`
+}
+
+// CapacityTracking defines the CSI storage capacity tracking of the csi-provisioner
+type CapacityTracking struct {
+	// Enabled sets storageCapacity in the CSIDriver, and the csi-provisioner publishes
+	// a CSIStorageCapacity for each storage class of the driver
+	Enabled bool `json:"enabled"`
+
+	// PollInterval is how often the csi-provisioner gets the capacity, 1m by default
+	// +kubebuilder:validation:Optional
+	PollInterval *metav1.Duration `json:"pollInterval,omitempty"`
+}
+
+// HostPrerequisites defines the multipath, udev and services configuration of the hosts,
+// the fields which are not set take the operator defaults
+type HostPrerequisites struct {
+	// Roles are the MachineConfigPool roles a MachineConfig is generated for, the default is worker
+	// +kubebuilder:validation:Optional
+	Roles []string `json:"roles,omitempty"`
+
+	// MultipathDevices are the device sections of multipath.conf,
+	// the default is the IBM FlashSystem, FlashSystem-9840 and 2145 devices of deploy/99-ibm-attach.yaml
+	// +kubebuilder:validation:Optional
+	MultipathDevices []MultipathDevice `json:"multipathDevices,omitempty"`
+
+	// NoPathRetry is the no_path_retry of the multipath devices, the default is fail
+	// +kubebuilder:validation:Optional
+	NoPathRetry string `json:"noPathRetry,omitempty"`
+
+	// DevLossTmo is the dev_loss_tmo of the multipath devices in seconds
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Minimum=0
+	DevLossTmo *int32 `json:"devLossTmo,omitempty"`
+
+	// SCSITimeout is the SCSI command timeout of the IBM 2145 devices in seconds, the default is 120
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Minimum=1
+	SCSITimeout *int32 `json:"scsiTimeout,omitempty"`
+
+	// Services are the systemd services enabled on the hosts, the default is multipathd.service,
+	// add iscsid.service for iSCSI connectivity
+	// +kubebuilder:validation:Optional
+	Services []string `json:"services,omitempty"`
+}
+
+// MultipathDevice defines a device section of multipath.conf
+type MultipathDevice struct {
+	Vendor  string `json:"vendor"`
+	Product string `json:"product"`
+
+	// +kubebuilder:validation:Optional
+	PathGroupingPolicy string `json:"pathGroupingPolicy,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	PathSelector string `json:"pathSelector,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	Prio string `json:"prio,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	FastIOFailTmo string `json:"fastIOFailTmo,omitempty"`
+
+	// RRMinIORq is the rr_min_io_rq of the device
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Minimum=1
+	RRMinIORq *int32 `json:"rrMinIORq,omitempty"`
+
+	// NoPathRetry overrides the no_path_retry of HostPrerequisites for this device
+	// +kubebuilder:validation:Optional
+	NoPathRetry string `json:"noPathRetry,omitempty"`
+
+	// DevLossTmo overrides the dev_loss_tmo of HostPrerequisites for this device
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Minimum=0
+	DevLossTmo *int32 `json:"devLossTmo,omitempty"`
 }
 
 // seems not work in this way, need to figure out why
@@ -93,6 +262,19 @@ type IBMBlockCSIControllerSpec struct {
 
 	// +kubebuilder:validation:Optional
 	Tolerations []corev1.Toleration `json:"tolerations,omitempty"`
+
+	// ManagementState overrides the management state of the IBMBlockCSI spec for this component
+	// +kubebuilder:validation:Optional
+	ManagementState ManagementState `json:"managementState,omitempty"`
+
+	// Overrides is a strategic merge patch applied on the generated pod template
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:pruning:PreserveUnknownFields
+	Overrides *runtime.RawExtension `json:"overrides,omitempty"`
+
+	// SecurityContext overrides the restricted security context of the controller pods
+	// +kubebuilder:validation:Optional
+	SecurityContext *SecurityContext `json:"securityContext,omitempty"`
 }
 
 // IBMBlockCSINodeSpec defines the desired state of IBMBlockCSINode
@@ -110,6 +292,20 @@ type IBMBlockCSINodeSpec struct {
 
 	// +kubebuilder:validation:Optional
 	Tolerations []corev1.Toleration `json:"tolerations,omitempty"`
+
+	// ManagementState overrides the management state of the IBMBlockCSI spec for this component
+	// +kubebuilder:validation:Optional
+	ManagementState ManagementState `json:"managementState,omitempty"`
+
+	// Overrides is a strategic merge patch applied on the generated pod template
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:pruning:PreserveUnknownFields
+	Overrides *runtime.RawExtension `json:"overrides,omitempty"`
+
+	// ConnectivityTypes are the connectivity types the node prepares the mounts and the kernel modules for,
+	// the default is the connectivity type of the HostDefiner, or fc and iscsi
+	// +kubebuilder:validation:Optional
+	ConnectivityTypes []ConnectivityType `json:"connectivityTypes,omitempty"`
 }
 
 // IBMBlockCSIStatus defines the observed state of IBMBlockCSI
@@ -119,10 +315,93 @@ type IBMBlockCSIStatus struct {
 	ControllerReady bool        `json:"controllerReady"`
 	NodeReady       bool        `json:"nodeReady"`
 
-	// Version is the current driver version
+	// Version is the driver version the controller and node plugins run,
+	// empty while they run different versions
 	Version string `json:"version"`
+
+	// ComponentVersions are the images the containers of the controller and node pods run
+	// +optional
+	ComponentVersions []ComponentVersion `json:"componentVersions,omitempty"`
+
+	// Paused is true when reconciliation is paused by the pause annotation
+	Paused bool `json:"paused,omitempty"`
+
+	// ControllerManagementState is the management state applied to the controller
+	ControllerManagementState ManagementState `json:"controllerManagementState,omitempty"`
+
+	// NodeManagementState is the management state applied to the node
+	NodeManagementState ManagementState `json:"nodeManagementState,omitempty"`
+
+	// ControllerOverridesHash is the hash of the overrides applied on the controller pod template
+	ControllerOverridesHash string `json:"controllerOverridesHash,omitempty"`
+
+	// NodeOverridesHash is the hash of the overrides applied on the node pod template
+	NodeOverridesHash string `json:"nodeOverridesHash,omitempty"`
+
+	// NodePlugin is the readiness breakdown of the node plugin pods
+	// +optional
+	NodePlugin *NodePluginStatus `json:"nodePlugin,omitempty"`
+
+	// MissingImagePullSecrets are the pull secrets of imagePullSecrets and imagePullSecretSources which do not exist
+	// +optional
+	MissingImagePullSecrets []string `json:"missingImagePullSecrets,omitempty"`
+
+	// InvalidImagePullSecrets are the pull secrets of imagePullSecretSources which are not copied,
+	// as they are not pull secrets or do not opt in to be copied
+	// +optional
+	InvalidImagePullSecrets []string `json:"invalidImagePullSecrets,omitempty"`
+
+	// Conditions are the latest observations of the driver state
+	// +listType=map
+	// +listMapKey=type
+	// +optional
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+}
+
+// NodePluginStatus defines the observed state of the node plugin pods
+type NodePluginStatus struct {
+	// DesiredNumber is the number of nodes which should run the node plugin
+	DesiredNumber int32 `json:"desiredNumber"`
+
+	// ReadyNumber is the number of nodes which run a ready node plugin
+	ReadyNumber int32 `json:"readyNumber"`
+
+	// UpdatedNumber is the number of nodes which run the latest node plugin pod template
+	UpdatedNumber int32 `json:"updatedNumber"`
+
+	// UnhealthyNumber is the number of nodes whose node plugin is unhealthy
+	UnhealthyNumber int32 `json:"unhealthyNumber"`
+
+	// UnhealthyNodes lists the first unhealthy nodes by name
+	// +optional
+	UnhealthyNodes []UnhealthyNode `json:"unhealthyNodes,omitempty"`
 }
 
+// UnhealthyNode defines a node whose node plugin pod is unhealthy
+type UnhealthyNode struct {
+	NodeName string `json:"nodeName"`
+	PodName  string `json:"podName"`
+
+	// Reason is CrashLoopBackOff, ImageMismatch or NotReady
+	Reason string `json:"reason"`
+
+	// +optional
+	Message string `json:"message,omitempty"`
+}
+
+const (
+	// ConditionNodePrerequisitesMet tells whether the preflight checks of the node plugin pass on all the nodes
+	ConditionNodePrerequisitesMet = "NodePrerequisitesMet"
+	// ConditionVersionSkew tells whether the controller plugin, the node plugin and the desired versions differ
+	ConditionVersionSkew = "VersionSkew"
+	// ConditionDegraded tells whether the operator refuses the spec
+	ConditionDegraded = "Degraded"
+	// ConditionUpgradeable tells whether the operator can be upgraded now
+	ConditionUpgradeable = "Upgradeable"
+	// ConditionSidecarAPIsAvailable tells whether the cluster serves the APIs of the optional sidecars
+	ConditionSidecarAPIsAvailable = "SidecarAPIsAvailable"
+)
+
 //+kubebuilder:object:root=true
 //+kubebuilder:subresource:status
 
@@ -0,0 +1,89 @@
+/**
+ * Copyright 2025 IBM Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package v1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// NodeConnectivityPolicySpec defines how the host definer defines the nodes which the policy selects
+type NodeConnectivityPolicySpec struct {
+	// NodeSelector selects the nodes of the policy by label
+	NodeSelector metav1.LabelSelector `json:"nodeSelector"`
+
+	// Priority orders the policies which select the same node, the policy with the highest priority applies,
+	// and the policy with the first name among policies with the same priority
+	// +kubebuilder:validation:Optional
+	Priority int32 `json:"priority,omitempty"`
+
+	// ConnectivityType is the connectivity type of the nodes, the connectivity type of the host definer if empty
+	// +kubebuilder:validation:Optional
+	ConnectivityType ConnectivityType `json:"connectivityType,omitempty"`
+
+	// PortSet is the port set of the hosts of the nodes on the storage
+	// +kubebuilder:validation:Optional
+	PortSet string `json:"portSet,omitempty"`
+
+	// IOGroups are the IO groups of the hosts of the nodes on the storage
+	// +kubebuilder:validation:Optional
+	IOGroups []int `json:"ioGroups,omitempty"`
+
+	// NodeNameOnStorageTemplate is the name of the hosts of the nodes on the storage,
+	// where ${node.name} is replaced by the name of the node
+	// +kubebuilder:validation:Optional
+	NodeNameOnStorageTemplate string `json:"nodeNameOnStorageTemplate,omitempty"`
+}
+
+// NodeConnectivityPolicyStatus defines the nodes the policy applies to
+type NodeConnectivityPolicyStatus struct {
+	// AppliedNodes is the number of nodes the policy applies to
+	AppliedNodes int32 `json:"appliedNodes"`
+
+	// Message tells why the policy is invalid, empty if it is valid
+	// +optional
+	Message string `json:"message,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+//+kubebuilder:subresource:status
+//+kubebuilder:resource:scope=Cluster
+
+// NodeConnectivityPolicy is the Schema for the nodeconnectivitypolicies API
+// +kubebuilder:printcolumn:name="Priority",type=integer,JSONPath=`.spec.priority`
+// +kubebuilder:printcolumn:name="Connectivity",type=string,JSONPath=`.spec.connectivityType`
+// +kubebuilder:printcolumn:name="Nodes",type=integer,JSONPath=`.status.appliedNodes`
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+type NodeConnectivityPolicy struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   NodeConnectivityPolicySpec   `json:"spec,omitempty"`
+	Status NodeConnectivityPolicyStatus `json:"status,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+
+// NodeConnectivityPolicyList contains a list of NodeConnectivityPolicy
+type NodeConnectivityPolicyList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []NodeConnectivityPolicy `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&NodeConnectivityPolicy{}, &NodeConnectivityPolicyList{})
+}
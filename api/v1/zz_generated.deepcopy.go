@@ -22,9 +22,58 @@ package v1
 
 import (
 	corev1 "k8s.io/api/core/v1"
+	storagev1 "k8s.io/api/storage/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CSIDriverOptions) DeepCopyInto(out *CSIDriverOptions) {
+	*out = *in
+	if in.PodInfoOnMount != nil {
+		in, out := &in.PodInfoOnMount, &out.PodInfoOnMount
+		*out = new(bool)
+		**out = **in
+	}
+	if in.FSGroupPolicy != nil {
+		in, out := &in.FSGroupPolicy, &out.FSGroupPolicy
+		*out = new(storagev1.FSGroupPolicy)
+		**out = **in
+	}
+	if in.VolumeLifecycleModes != nil {
+		in, out := &in.VolumeLifecycleModes, &out.VolumeLifecycleModes
+		*out = make([]storagev1.VolumeLifecycleMode, len(*in))
+		copy(*out, *in)
+	}
+	if in.SELinuxMount != nil {
+		in, out := &in.SELinuxMount, &out.SELinuxMount
+		*out = new(bool)
+		**out = **in
+	}
+	if in.RequiresRepublish != nil {
+		in, out := &in.RequiresRepublish, &out.RequiresRepublish
+		*out = new(bool)
+		**out = **in
+	}
+	if in.TokenRequests != nil {
+		in, out := &in.TokenRequests, &out.TokenRequests
+		*out = make([]storagev1.TokenRequest, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CSIDriverOptions.
+func (in *CSIDriverOptions) DeepCopy() *CSIDriverOptions {
+	if in == nil {
+		return nil
+	}
+	out := new(CSIDriverOptions)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CSISidecar) DeepCopyInto(out *CSISidecar) {
 	*out = *in
@@ -40,6 +89,61 @@ func (in *CSISidecar) DeepCopy() *CSISidecar {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CallHome) DeepCopyInto(out *CallHome) {
+	*out = *in
+	if in.Proxy != nil {
+		in, out := &in.Proxy, &out.Proxy
+		*out = new(ProxyConfig)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CallHome.
+func (in *CallHome) DeepCopy() *CallHome {
+	if in == nil {
+		return nil
+	}
+	out := new(CallHome)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CapacityTracking) DeepCopyInto(out *CapacityTracking) {
+	*out = *in
+	if in.PollInterval != nil {
+		in, out := &in.PollInterval, &out.PollInterval
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CapacityTracking.
+func (in *CapacityTracking) DeepCopy() *CapacityTracking {
+	if in == nil {
+		return nil
+	}
+	out := new(CapacityTracking)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ComponentVersion) DeepCopyInto(out *ComponentVersion) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ComponentVersion.
+func (in *ComponentVersion) DeepCopy() *ComponentVersion {
+	if in == nil {
+		return nil
+	}
+	out := new(ComponentVersion)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Definition) DeepCopyInto(out *Definition) {
 	*out = *in
@@ -65,13 +169,28 @@ func (in *Definition) DeepCopy() *Definition {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HealthMonitor) DeepCopyInto(out *HealthMonitor) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HealthMonitor.
+func (in *HealthMonitor) DeepCopy() *HealthMonitor {
+	if in == nil {
+		return nil
+	}
+	out := new(HealthMonitor)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *HostDefiner) DeepCopyInto(out *HostDefiner) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
 	in.Spec.DeepCopyInto(&out.Spec)
-	out.Status = in.Status
+	in.Status.DeepCopyInto(&out.Status)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HostDefiner.
@@ -133,6 +252,26 @@ func (in *HostDefinerSpec) DeepCopyInto(out *HostDefinerSpec) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.ImagePullSecretSources != nil {
+		in, out := &in.ImagePullSecretSources, &out.ImagePullSecretSources
+		*out = make([]ImagePullSecretSource, len(*in))
+		copy(*out, *in)
+	}
+	if in.TrustedCA != nil {
+		in, out := &in.TrustedCA, &out.TrustedCA
+		*out = new(TrustedCA)
+		**out = **in
+	}
+	if in.NetworkPolicy != nil {
+		in, out := &in.NetworkPolicy, &out.NetworkPolicy
+		*out = new(NetworkPolicy)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.IOGroupPlacement != nil {
+		in, out := &in.IOGroupPlacement, &out.IOGroupPlacement
+		*out = new(IOGroupPlacement)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HostDefinerSpec.
@@ -148,6 +287,31 @@ func (in *HostDefinerSpec) DeepCopy() *HostDefinerSpec {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *HostDefinerStatus) DeepCopyInto(out *HostDefinerStatus) {
 	*out = *in
+	if in.ComponentVersions != nil {
+		in, out := &in.ComponentVersions, &out.ComponentVersions
+		*out = make([]ComponentVersion, len(*in))
+		copy(*out, *in)
+	}
+	if in.MissingImagePullSecrets != nil {
+		in, out := &in.MissingImagePullSecrets, &out.MissingImagePullSecrets
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.InvalidImagePullSecrets != nil {
+		in, out := &in.InvalidImagePullSecrets, &out.InvalidImagePullSecrets
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.InvalidArraySecrets != nil {
+		in, out := &in.InvalidArraySecrets, &out.InvalidArraySecrets
+		*out = make([]InvalidArraySecret, len(*in))
+		copy(*out, *in)
+	}
+	if in.IOGroupDistribution != nil {
+		in, out := &in.IOGroupDistribution, &out.IOGroupDistribution
+		*out = make([]IOGroupNodes, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HostDefinerStatus.
@@ -250,13 +414,55 @@ func (in *HostDefinitionStatus) DeepCopy() *HostDefinitionStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HostPrerequisites) DeepCopyInto(out *HostPrerequisites) {
+	*out = *in
+	if in.Roles != nil {
+		in, out := &in.Roles, &out.Roles
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.MultipathDevices != nil {
+		in, out := &in.MultipathDevices, &out.MultipathDevices
+		*out = make([]MultipathDevice, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.DevLossTmo != nil {
+		in, out := &in.DevLossTmo, &out.DevLossTmo
+		*out = new(int32)
+		**out = **in
+	}
+	if in.SCSITimeout != nil {
+		in, out := &in.SCSITimeout, &out.SCSITimeout
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Services != nil {
+		in, out := &in.Services, &out.Services
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HostPrerequisites.
+func (in *HostPrerequisites) DeepCopy() *HostPrerequisites {
+	if in == nil {
+		return nil
+	}
+	out := new(HostPrerequisites)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *IBMBlockCSI) DeepCopyInto(out *IBMBlockCSI) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
 	in.Spec.DeepCopyInto(&out.Spec)
-	out.Status = in.Status
+	in.Status.DeepCopyInto(&out.Status)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IBMBlockCSI.
@@ -292,6 +498,16 @@ func (in *IBMBlockCSIControllerSpec) DeepCopyInto(out *IBMBlockCSIControllerSpec
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.Overrides != nil {
+		in, out := &in.Overrides, &out.Overrides
+		*out = new(runtime.RawExtension)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SecurityContext != nil {
+		in, out := &in.SecurityContext, &out.SecurityContext
+		*out = new(SecurityContext)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IBMBlockCSIControllerSpec.
@@ -351,6 +567,16 @@ func (in *IBMBlockCSINodeSpec) DeepCopyInto(out *IBMBlockCSINodeSpec) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.Overrides != nil {
+		in, out := &in.Overrides, &out.Overrides
+		*out = new(runtime.RawExtension)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ConnectivityTypes != nil {
+		in, out := &in.ConnectivityTypes, &out.ConnectivityTypes
+		*out = make([]ConnectivityType, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IBMBlockCSINodeSpec.
@@ -378,6 +604,46 @@ func (in *IBMBlockCSISpec) DeepCopyInto(out *IBMBlockCSISpec) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.ImagePullSecretSources != nil {
+		in, out := &in.ImagePullSecretSources, &out.ImagePullSecretSources
+		*out = make([]ImagePullSecretSource, len(*in))
+		copy(*out, *in)
+	}
+	if in.HostPrerequisites != nil {
+		in, out := &in.HostPrerequisites, &out.HostPrerequisites
+		*out = new(HostPrerequisites)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.CapacityTracking != nil {
+		in, out := &in.CapacityTracking, &out.CapacityTracking
+		*out = new(CapacityTracking)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.HealthMonitor != nil {
+		in, out := &in.HealthMonitor, &out.HealthMonitor
+		*out = new(HealthMonitor)
+		**out = **in
+	}
+	if in.CSIDriver != nil {
+		in, out := &in.CSIDriver, &out.CSIDriver
+		*out = new(CSIDriverOptions)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.CallHome != nil {
+		in, out := &in.CallHome, &out.CallHome
+		*out = new(CallHome)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.TrustedCA != nil {
+		in, out := &in.TrustedCA, &out.TrustedCA
+		*out = new(TrustedCA)
+		**out = **in
+	}
+	if in.NetworkPolicy != nil {
+		in, out := &in.NetworkPolicy, &out.NetworkPolicy
+		*out = new(NetworkPolicy)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IBMBlockCSISpec.
@@ -393,6 +659,33 @@ func (in *IBMBlockCSISpec) DeepCopy() *IBMBlockCSISpec {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *IBMBlockCSIStatus) DeepCopyInto(out *IBMBlockCSIStatus) {
 	*out = *in
+	if in.ComponentVersions != nil {
+		in, out := &in.ComponentVersions, &out.ComponentVersions
+		*out = make([]ComponentVersion, len(*in))
+		copy(*out, *in)
+	}
+	if in.NodePlugin != nil {
+		in, out := &in.NodePlugin, &out.NodePlugin
+		*out = new(NodePluginStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.MissingImagePullSecrets != nil {
+		in, out := &in.MissingImagePullSecrets, &out.MissingImagePullSecrets
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.InvalidImagePullSecrets != nil {
+		in, out := &in.InvalidImagePullSecrets, &out.InvalidImagePullSecrets
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]metav1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IBMBlockCSIStatus.
@@ -420,6 +713,16 @@ func (in *IBMBlockHostDefinerSpec) DeepCopyInto(out *IBMBlockHostDefinerSpec) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.Overrides != nil {
+		in, out := &in.Overrides, &out.Overrides
+		*out = new(runtime.RawExtension)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SecurityContext != nil {
+		in, out := &in.SecurityContext, &out.SecurityContext
+		*out = new(SecurityContext)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IBMBlockHostDefinerSpec.
@@ -431,3 +734,334 @@ func (in *IBMBlockHostDefinerSpec) DeepCopy() *IBMBlockHostDefinerSpec {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IOGroupNodes) DeepCopyInto(out *IOGroupNodes) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IOGroupNodes.
+func (in *IOGroupNodes) DeepCopy() *IOGroupNodes {
+	if in == nil {
+		return nil
+	}
+	out := new(IOGroupNodes)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IOGroupPlacement) DeepCopyInto(out *IOGroupPlacement) {
+	*out = *in
+	if in.IOGroups != nil {
+		in, out := &in.IOGroups, &out.IOGroups
+		*out = make([]int, len(*in))
+		copy(*out, *in)
+	}
+	if in.Mapping != nil {
+		in, out := &in.Mapping, &out.Mapping
+		*out = make(map[string][]int, len(*in))
+		for key, val := range *in {
+			var outVal []int
+			if val == nil {
+				(*out)[key] = nil
+			} else {
+				inVal := (*in)[key]
+				in, out := &inVal, &outVal
+				*out = make([]int, len(*in))
+				copy(*out, *in)
+			}
+			(*out)[key] = outVal
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IOGroupPlacement.
+func (in *IOGroupPlacement) DeepCopy() *IOGroupPlacement {
+	if in == nil {
+		return nil
+	}
+	out := new(IOGroupPlacement)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ImagePullSecretSource) DeepCopyInto(out *ImagePullSecretSource) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ImagePullSecretSource.
+func (in *ImagePullSecretSource) DeepCopy() *ImagePullSecretSource {
+	if in == nil {
+		return nil
+	}
+	out := new(ImagePullSecretSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InvalidArraySecret) DeepCopyInto(out *InvalidArraySecret) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InvalidArraySecret.
+func (in *InvalidArraySecret) DeepCopy() *InvalidArraySecret {
+	if in == nil {
+		return nil
+	}
+	out := new(InvalidArraySecret)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MultipathDevice) DeepCopyInto(out *MultipathDevice) {
+	*out = *in
+	if in.RRMinIORq != nil {
+		in, out := &in.RRMinIORq, &out.RRMinIORq
+		*out = new(int32)
+		**out = **in
+	}
+	if in.DevLossTmo != nil {
+		in, out := &in.DevLossTmo, &out.DevLossTmo
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MultipathDevice.
+func (in *MultipathDevice) DeepCopy() *MultipathDevice {
+	if in == nil {
+		return nil
+	}
+	out := new(MultipathDevice)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NetworkPolicy) DeepCopyInto(out *NetworkPolicy) {
+	*out = *in
+	if in.ArrayCIDRs != nil {
+		in, out := &in.ArrayCIDRs, &out.ArrayCIDRs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.MetricsPorts != nil {
+		in, out := &in.MetricsPorts, &out.MetricsPorts
+		*out = make([]int32, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkPolicy.
+func (in *NetworkPolicy) DeepCopy() *NetworkPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(NetworkPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NodeConnectivityPolicy) DeepCopyInto(out *NodeConnectivityPolicy) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	out.Status = in.Status
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodeConnectivityPolicy.
+func (in *NodeConnectivityPolicy) DeepCopy() *NodeConnectivityPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(NodeConnectivityPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *NodeConnectivityPolicy) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NodeConnectivityPolicyList) DeepCopyInto(out *NodeConnectivityPolicyList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]NodeConnectivityPolicy, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodeConnectivityPolicyList.
+func (in *NodeConnectivityPolicyList) DeepCopy() *NodeConnectivityPolicyList {
+	if in == nil {
+		return nil
+	}
+	out := new(NodeConnectivityPolicyList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *NodeConnectivityPolicyList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NodeConnectivityPolicySpec) DeepCopyInto(out *NodeConnectivityPolicySpec) {
+	*out = *in
+	in.NodeSelector.DeepCopyInto(&out.NodeSelector)
+	if in.IOGroups != nil {
+		in, out := &in.IOGroups, &out.IOGroups
+		*out = make([]int, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodeConnectivityPolicySpec.
+func (in *NodeConnectivityPolicySpec) DeepCopy() *NodeConnectivityPolicySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(NodeConnectivityPolicySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NodeConnectivityPolicyStatus) DeepCopyInto(out *NodeConnectivityPolicyStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodeConnectivityPolicyStatus.
+func (in *NodeConnectivityPolicyStatus) DeepCopy() *NodeConnectivityPolicyStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(NodeConnectivityPolicyStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NodePluginStatus) DeepCopyInto(out *NodePluginStatus) {
+	*out = *in
+	if in.UnhealthyNodes != nil {
+		in, out := &in.UnhealthyNodes, &out.UnhealthyNodes
+		*out = make([]UnhealthyNode, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodePluginStatus.
+func (in *NodePluginStatus) DeepCopy() *NodePluginStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(NodePluginStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProxyConfig) DeepCopyInto(out *ProxyConfig) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProxyConfig.
+func (in *ProxyConfig) DeepCopy() *ProxyConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(ProxyConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SecurityContext) DeepCopyInto(out *SecurityContext) {
+	*out = *in
+	if in.RunAsUser != nil {
+		in, out := &in.RunAsUser, &out.RunAsUser
+		*out = new(int64)
+		**out = **in
+	}
+	if in.RunAsGroup != nil {
+		in, out := &in.RunAsGroup, &out.RunAsGroup
+		*out = new(int64)
+		**out = **in
+	}
+	if in.FSGroup != nil {
+		in, out := &in.FSGroup, &out.FSGroup
+		*out = new(int64)
+		**out = **in
+	}
+	if in.ReadOnlyRootFilesystem != nil {
+		in, out := &in.ReadOnlyRootFilesystem, &out.ReadOnlyRootFilesystem
+		*out = new(bool)
+		**out = **in
+	}
+	if in.SeccompProfile != nil {
+		in, out := &in.SeccompProfile, &out.SeccompProfile
+		*out = new(corev1.SeccompProfile)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecurityContext.
+func (in *SecurityContext) DeepCopy() *SecurityContext {
+	if in == nil {
+		return nil
+	}
+	out := new(SecurityContext)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TrustedCA) DeepCopyInto(out *TrustedCA) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TrustedCA.
+func (in *TrustedCA) DeepCopy() *TrustedCA {
+	if in == nil {
+		return nil
+	}
+	out := new(TrustedCA)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *UnhealthyNode) DeepCopyInto(out *UnhealthyNode) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UnhealthyNode.
+func (in *UnhealthyNode) DeepCopy() *UnhealthyNode {
+	if in == nil {
+		return nil
+	}
+	out := new(UnhealthyNode)
+	in.DeepCopyInto(out)
+	return out
+}
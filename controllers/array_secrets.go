@@ -0,0 +1,119 @@
+/**
+ * Copyright 2025 IBM Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package controllers
+
+import (
+	"context"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	storagev1 "k8s.io/api/storage/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/selection"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	csiv1 "github.com/IBM/ibm-block-csi-operator/api/v1"
+	"github.com/IBM/ibm-block-csi-operator/controllers/internal/common"
+	"github.com/IBM/ibm-block-csi-operator/controllers/internal/hostdefiner"
+	oconfig "github.com/IBM/ibm-block-csi-operator/pkg/config"
+)
+
+// GetArraySecretSelector returns the selector of the labelled storage array Secrets,
+// the manager caches these Secrets only, of all the namespaces
+func GetArraySecretSelector() labels.Selector {
+	requirement, err := labels.NewRequirement(oconfig.ArraySecretLabel, selection.Exists, nil)
+	if err != nil {
+		panic(err)
+	}
+	return labels.NewSelector().Add(*requirement)
+}
+
+// getReferencedArraySecrets returns the array Secrets which the HostDefinitions or the StorageClasses
+// of the driver reference
+func getReferencedArraySecrets(c client.Client,
+	hostDefinitions []csiv1.HostDefinition) (sets.Set[types.NamespacedName], error) {
+	referenced := sets.New[types.NamespacedName]()
+	for i := range hostDefinitions {
+		referenced.Insert(types.NamespacedName{
+			Name:      hostDefinitions[i].Spec.HostDefinition.SecretName,
+			Namespace: hostDefinitions[i].Spec.HostDefinition.SecretNamespace,
+		})
+	}
+
+	storageClasses := &storagev1.StorageClassList{}
+	if err := c.List(context.TODO(), storageClasses); err != nil {
+		return nil, err
+	}
+	for i := range storageClasses.Items {
+		referenced.Insert(common.GetStorageClassSecrets(&storageClasses.Items[i])...)
+	}
+	return referenced, nil
+}
+
+// reconcileArraySecrets validates the labelled storage array Secrets, annotates the HostDefinitions
+// which use them with their version and validity, and sets the checksum of the referenced Secrets
+func (r *HostDefinerReconciler) reconcileArraySecrets(instance *hostdefiner.HostDefiner) error {
+	logger := hostDefinerLog.WithValues("Resource Type", "Secret")
+
+	secrets := &corev1.SecretList{}
+	if err := r.List(context.TODO(), secrets, client.MatchingLabelsSelector{Selector: GetArraySecretSelector()}); err != nil {
+		return err
+	}
+	hostDefinitions := &csiv1.HostDefinitionList{}
+	if err := r.List(context.TODO(), hostDefinitions); err != nil {
+		return err
+	}
+	referenced, err := getReferencedArraySecrets(r.Client, hostDefinitions.Items)
+	if err != nil {
+		return err
+	}
+	instance.SetArraySecrets(secrets.Items, referenced)
+	for _, invalidSecret := range instance.Status.InvalidArraySecrets {
+		r.Recorder.Event(instance.Unwrap(), corev1.EventTypeWarning, "InvalidArraySecret",
+			fmt.Sprintf("the array Secret %s/%s is invalid: %s",
+				invalidSecret.Namespace, invalidSecret.Name, invalidSecret.Message))
+	}
+
+	secretsByKey := map[types.NamespacedName]*corev1.Secret{}
+	for i := range secrets.Items {
+		secretsByKey[client.ObjectKeyFromObject(&secrets.Items[i])] = &secrets.Items[i]
+	}
+
+	for i := range hostDefinitions.Items {
+		hostDefinition := &hostDefinitions.Items[i]
+		secret, found := secretsByKey[types.NamespacedName{
+			Name:      hostDefinition.Spec.HostDefinition.SecretName,
+			Namespace: hostDefinition.Spec.HostDefinition.SecretNamespace,
+		}]
+		if !found {
+			continue
+		}
+		patch := client.MergeFrom(hostDefinition.DeepCopy())
+		if !common.SetHostDefinitionArraySecretAnnotations(hostDefinition,
+			common.GetArraySecretVersion(secret), common.ValidateArraySecret(secret) == nil) {
+			continue
+		}
+		logger.Info("annotating HostDefinition with its array Secret", "HostDefinition", hostDefinition.Name,
+			"Secret", client.ObjectKeyFromObject(secret))
+		if err := r.Patch(context.TODO(), hostDefinition, patch); err != nil {
+			return err
+		}
+	}
+	return nil
+}
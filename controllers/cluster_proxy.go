@@ -0,0 +1,83 @@
+/**
+ * Copyright 2025 IBM Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package controllers
+
+import (
+	"context"
+	"os"
+
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	csiv1 "github.com/IBM/ibm-block-csi-operator/api/v1"
+	"github.com/IBM/ibm-block-csi-operator/controllers/util/common"
+	oconfig "github.com/IBM/ibm-block-csi-operator/pkg/config"
+)
+
+var clusterProxyGroupVersionKind = schema.GroupVersionKind{
+	Group:   oconfig.ClusterProxyApiGroup,
+	Version: oconfig.ClusterProxyVersion,
+	Kind:    oconfig.ClusterProxyKind,
+}
+
+// newClusterProxyMetadata returns the metadata of the cluster-wide Proxy, to watch it without its types
+func newClusterProxyMetadata() *metav1.PartialObjectMetadata {
+	return &metav1.PartialObjectMetadata{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: clusterProxyGroupVersionKind.GroupVersion().String(),
+			Kind:       clusterProxyGroupVersionKind.Kind,
+		},
+	}
+}
+
+// getClusterProxy returns the cluster-wide proxy, nil if there is none. The proxy env vars of the operator
+// take precedence over the status of the Proxy of OpenShift, where OpenShift completes its noProxy.
+func getClusterProxy(c client.Client) (*csiv1.ProxyConfig, error) {
+	proxy := &csiv1.ProxyConfig{
+		HTTPProxy:  os.Getenv(oconfig.ENVHTTPProxy),
+		HTTPSProxy: os.Getenv(oconfig.ENVHTTPSProxy),
+		NoProxy:    os.Getenv(oconfig.ENVNoProxy),
+	}
+	if proxy.HTTPProxy != "" || proxy.HTTPSProxy != "" {
+		return proxy, nil
+	}
+
+	available, err := common.NewControllerHelper(c).IsAPIAvailable(clusterProxyGroupVersionKind)
+	if err != nil || !available {
+		return nil, err
+	}
+	clusterProxy := &unstructured.Unstructured{}
+	clusterProxy.SetGroupVersionKind(clusterProxyGroupVersionKind)
+	if err := c.Get(context.TODO(), types.NamespacedName{Name: oconfig.ClusterProxyName}, clusterProxy); err != nil {
+		if errors.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	proxy.HTTPProxy, _, _ = unstructured.NestedString(clusterProxy.Object, "status", "httpProxy")
+	proxy.HTTPSProxy, _, _ = unstructured.NestedString(clusterProxy.Object, "status", "httpsProxy")
+	proxy.NoProxy, _, _ = unstructured.NestedString(clusterProxy.Object, "status", "noProxy")
+	if proxy.HTTPProxy != "" || proxy.HTTPSProxy != "" {
+		return proxy, nil
+	}
+	return nil, nil
+}
@@ -0,0 +1,61 @@
+/**
+ * Copyright 2025 IBM Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package controllers
+
+import (
+	"context"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+)
+
+// createConfigMapIfNotFound creates a ConfigMap owned by owner if it does not exist,
+// for the ConfigMaps whose data the operator does not own
+func createConfigMapIfNotFound(c client.Client, scheme *runtime.Scheme, owner metav1.Object, configMap *corev1.ConfigMap) error {
+	logger := log.WithValues("Resource Type", "ConfigMap")
+
+	if err := controllerutil.SetControllerReference(owner, configMap, scheme); err != nil {
+		return err
+	}
+	err := c.Get(context.TODO(), types.NamespacedName{
+		Name:      configMap.Name,
+		Namespace: configMap.Namespace,
+	}, &corev1.ConfigMap{})
+	if err != nil && errors.IsNotFound(err) {
+		logger.Info("Creating a new ConfigMap", "Namespace", configMap.GetNamespace(), "Name", configMap.GetName())
+		return c.Create(context.TODO(), configMap)
+	}
+	return err
+}
+
+// getConfigMapIfFound returns a ConfigMap, nil if it does not exist
+func getConfigMapIfFound(c client.Client, name, namespace string) (*corev1.ConfigMap, error) {
+	configMap := &corev1.ConfigMap{}
+	err := c.Get(context.TODO(), types.NamespacedName{Name: name, Namespace: namespace}, configMap)
+	if errors.IsNotFound(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return configMap, nil
+}
@@ -26,13 +26,15 @@ import (
 	"github.com/IBM/ibm-block-csi-operator/controllers/internal/hostdefiner"
 	clustersyncer "github.com/IBM/ibm-block-csi-operator/controllers/syncer"
 	"github.com/IBM/ibm-block-csi-operator/controllers/util"
+	"github.com/IBM/ibm-block-csi-operator/controllers/util/common"
 	oconfig "github.com/IBM/ibm-block-csi-operator/pkg/config"
-	oversion "github.com/IBM/ibm-block-csi-operator/version"
 	"github.com/go-logr/logr"
 	"github.com/presslabs/controller-util/pkg/syncer"
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
 	rbacv1 "k8s.io/api/rbac/v1"
+	storagev1 "k8s.io/api/storage/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -40,9 +42,12 @@ import (
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/tools/record"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
 	csiv1 "github.com/IBM/ibm-block-csi-operator/api/v1"
@@ -56,6 +61,8 @@ type HostDefinerReconciler struct {
 	client.Client
 	Scheme   *runtime.Scheme
 	Recorder record.EventRecorder
+	// APIReader reads the objects which are not in the namespaces of the cache
+	APIReader client.Reader
 }
 
 func (r *HostDefinerReconciler) Reconcile(ctx context.Context, req ctrl.Request) (reconcile.Result, error) {
@@ -87,6 +94,9 @@ func (r *HostDefinerReconciler) Reconcile(ctx context.Context, req ctrl.Request)
 		}
 		return reconcile.Result{}, nil
 	}
+	if err := instance.Validate(); err != nil {
+		return reconcile.Result{}, fmt.Errorf("wrong HostDefiner options: %v", err)
+	}
 	if err := r.addFinalizerIfNotPresent(instance); err != nil {
 		return reconcile.Result{}, err
 	}
@@ -105,6 +115,10 @@ func (r *HostDefinerReconciler) Reconcile(ctx context.Context, req ctrl.Request)
 			return reconcile.Result{}, err
 		}
 
+		if err := r.unlabelNodes(); err != nil {
+			return reconcile.Result{}, err
+		}
+
 		if err := r.removeFinalizer(instance); err != nil {
 			return reconcile.Result{}, err
 		}
@@ -112,18 +126,38 @@ func (r *HostDefinerReconciler) Reconcile(ctx context.Context, req ctrl.Request)
 	}
 	originalStatus := *instance.Status.DeepCopy()
 
+	if instance.IsReconcilePaused() {
+		reqLogger.Info("Reconciling HostDefiner is paused", "annotation", oconfig.PauseReconcileAnnotation)
+		instance.Status.Paused = true
+		return reconcile.Result{}, r.writeStatus(instance, originalStatus)
+	}
+
+	if instance.ClusterProxy, err = getClusterProxy(r.Client); err != nil {
+		return reconcile.Result{}, err
+	}
+
 	for _, rec := range []hostDefinerReconciler{
+		r.reconcileImagePullSecrets,
 		r.reconcileServiceAccount,
 		r.reconcileClusterRole,
 		r.reconcileClusterRoleBinding,
+		r.reconcileTrustedCABundleConfigMap,
+		r.reconcileNetworkPolicy,
+		r.reconcileArraySecrets,
+		r.reconcileNodeConnectivityPolicies,
+		r.reconcileIOGroupPlacement,
 	} {
 		if err = rec(instance); err != nil {
 			return reconcile.Result{}, err
 		}
 	}
 
-	hostDefinerSyncer := clustersyncer.NewHostDefinerSyncer(r.Client, r.Scheme, instance)
-	if err := syncer.Sync(context.TODO(), hostDefinerSyncer, r.Recorder); err != nil {
+	if instance.TrustedCABundleChecksum, err = getTrustedCABundleChecksum(r.Client,
+		instance.GetTrustedCAConfigMapName(), instance.Namespace); err != nil {
+		return reconcile.Result{}, err
+	}
+
+	if err := r.syncDeployment(instance); err != nil {
 		return reconcile.Result{}, err
 	}
 
@@ -131,15 +165,81 @@ func (r *HostDefinerReconciler) Reconcile(ctx context.Context, req ctrl.Request)
 		return reconcile.Result{}, err
 	}
 
+	if isImagePullSecretsResyncNeeded(instance.Spec.ImagePullSecretSources, instance.Status.MissingImagePullSecrets) {
+		return reconcile.Result{RequeueAfter: ReconcileTime}, nil
+	}
 	return reconcile.Result{}, nil
 }
 
+func (r *HostDefinerReconciler) syncDeployment(instance *hostdefiner.HostDefiner) error {
+	logger := hostDefinerLog.WithValues("Resource Type", "Deployment")
+
+	switch instance.GetHostDefinerManagementState() {
+	case csiv1.ManagementStateUnmanaged:
+		logger.Info("Skip sync: hostDefiner is unmanaged")
+		return nil
+	case csiv1.ManagementStateRemoved:
+		deployment, err := r.getDeployment(instance)
+		if errors.IsNotFound(err) {
+			instance.Status.HostDefinerOverridesHash = ""
+			return nil
+		} else if err != nil {
+			return err
+		}
+		logger.Info("deleting hostDefiner Deployment", "Name", deployment.GetName())
+		instance.Status.HostDefinerOverridesHash = ""
+		return r.Delete(context.TODO(), deployment)
+	}
+
+	hostDefinerSyncer := clustersyncer.NewHostDefinerSyncer(r.Client, r.Scheme, instance)
+	if err := syncer.Sync(context.TODO(), hostDefinerSyncer, r.Recorder); err != nil {
+		return err
+	}
+	instance.Status.HostDefinerOverridesHash = instance.GetHostDefinerOverridesHash()
+	return nil
+}
+
 func (r *HostDefinerReconciler) SetupWithManager(mgr ctrl.Manager) error {
-	return ctrl.NewControllerManagedBy(mgr).
+	controllerBuilder := ctrl.NewControllerManagedBy(mgr).
 		For(&csiv1.HostDefiner{}).
 		Owns(&appsv1.Deployment{}).
 		Owns(&corev1.ServiceAccount{}).
-		Complete(r)
+		Owns(&networkingv1.NetworkPolicy{}).
+		Watches(&corev1.ConfigMap{}, handler.EnqueueRequestsFromMapFunc(r.getHostDefinerRequestsOfTrustedCA)).
+		Watches(&storagev1.StorageClass{}, handler.EnqueueRequestsFromMapFunc(r.getHostDefinerRequests),
+			builder.WithPredicates(predicate.NewPredicateFuncs(isDriverStorageClass))).
+		Watches(&corev1.Secret{}, handler.EnqueueRequestsFromMapFunc(r.getHostDefinerRequests)).
+		Watches(&csiv1.HostDefinition{}, handler.EnqueueRequestsFromMapFunc(r.getHostDefinerRequests),
+			builder.WithPredicates(predicate.GenerationChangedPredicate{})).
+		Watches(&csiv1.NodeConnectivityPolicy{}, handler.EnqueueRequestsFromMapFunc(r.getHostDefinerRequests),
+			builder.WithPredicates(predicate.GenerationChangedPredicate{})).
+		Watches(&corev1.Node{}, handler.EnqueueRequestsFromMapFunc(r.getHostDefinerRequests),
+			builder.WithPredicates(predicate.LabelChangedPredicate{}))
+
+	clusterProxyAvailable, err := common.NewControllerHelper(r.Client).IsAPIAvailable(clusterProxyGroupVersionKind)
+	if err != nil {
+		return err
+	}
+	if clusterProxyAvailable {
+		controllerBuilder = controllerBuilder.Watches(newClusterProxyMetadata(),
+			handler.EnqueueRequestsFromMapFunc(r.getHostDefinerRequests))
+	}
+	return controllerBuilder.Complete(r)
+}
+
+// getHostDefinerRequests returns reconcile requests for all the HostDefiners
+func (r *HostDefinerReconciler) getHostDefinerRequests(ctx context.Context, _ client.Object) []reconcile.Request {
+	hostDefiners := &csiv1.HostDefinerList{}
+	if err := r.List(ctx, hostDefiners); err != nil {
+		hostDefinerLog.Error(err, "failed to list HostDefiners")
+		return nil
+	}
+
+	var requests []reconcile.Request
+	for _, hostDefiner := range hostDefiners.Items {
+		requests = append(requests, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(&hostDefiner)})
+	}
+	return requests
 }
 
 func (r *HostDefinerReconciler) addFinalizerIfNotPresent(instance *hostdefiner.HostDefiner) error {
@@ -275,11 +375,7 @@ func (r *HostDefinerReconciler) deleteClusterRoleBindings(instance *hostdefiner.
 }
 
 func (r *HostDefinerReconciler) getClusterRoleBindings(instance *hostdefiner.HostDefiner) []*rbacv1.ClusterRoleBinding {
-	hostdefiner := instance.GenerateHostDefinerClusterRoleBinding()
-
-	return []*rbacv1.ClusterRoleBinding{
-		hostdefiner,
-	}
+	return instance.GenerateClusterRoleBindings()
 }
 
 func (r *HostDefinerReconciler) reconcileClusterRole(instance *hostdefiner.HostDefiner) error {
@@ -342,11 +438,7 @@ func (r *HostDefinerReconciler) deleteClusterRoles(instance *hostdefiner.HostDef
 }
 
 func (r *HostDefinerReconciler) getClusterRoles(instance *hostdefiner.HostDefiner) []*rbacv1.ClusterRole {
-	hostdefiner := instance.GenerateHostDefinerClusterRole()
-
-	return []*rbacv1.ClusterRole{
-		hostdefiner,
-	}
+	return instance.GenerateClusterRoles()
 }
 
 func (r *HostDefinerReconciler) reconcileServiceAccount(instance *hostdefiner.HostDefiner) error {
@@ -380,6 +472,8 @@ func (r *HostDefinerReconciler) reconcileServiceAccount(instance *hostdefiner.Ho
 		} else if err != nil {
 			logger.Error(err, "Failed to get ServiceAccount", "Name", sa.GetName())
 			return err
+		} else if err := updateServiceAccountImagePullSecrets(r.Client, found, instance.GetImagePullSecrets()); err != nil {
+			return err
 		}
 	}
 
@@ -425,13 +519,26 @@ func (r *HostDefinerReconciler) rolloutRestartDeployment(deployment *appsv1.Depl
 }
 
 func (r *HostDefinerReconciler) updateStatus(instance *hostdefiner.HostDefiner, originalStatus csiv1.HostDefinerStatus) error {
-	logger := log.WithName("updateStatus")
+	managementState := instance.GetHostDefinerManagementState()
 	deployment, err := r.getDeployment(instance)
+	found, err := isWorkloadFound(err, managementState)
+	if err != nil {
+		return err
+	}
+
+	r.updateStatusFields(instance, deployment, found, managementState)
+
+	pods, err := listPods(r.Client, instance.GetHostDefinerSelectorLabels(), instance.Namespace)
 	if err != nil {
 		return err
 	}
+	instance.SetComponentVersions(pods)
+
+	return r.writeStatus(instance, originalStatus)
+}
 
-	r.updateStatusFields(instance, deployment)
+func (r *HostDefinerReconciler) writeStatus(instance *hostdefiner.HostDefiner, originalStatus csiv1.HostDefinerStatus) error {
+	logger := log.WithName("writeStatus")
 
 	if !reflect.DeepEqual(originalStatus, instance.Status) {
 		logger.Info("updating HostDefiner status", "name", instance.Name, "from", originalStatus, "to", instance.Status)
@@ -444,14 +551,16 @@ func (r *HostDefinerReconciler) updateStatus(instance *hostdefiner.HostDefiner,
 	return nil
 }
 
-func (r *HostDefinerReconciler) updateStatusFields(instance *hostdefiner.HostDefiner, deployment *appsv1.Deployment) {
-	instance.Status.HostDefinerReady = r.isReady(deployment)
+func (r *HostDefinerReconciler) updateStatusFields(instance *hostdefiner.HostDefiner, deployment *appsv1.Deployment,
+	found bool, managementState csiv1.ManagementState) {
+	instance.Status.Paused = false
+	instance.Status.HostDefinerManagementState = managementState
+	instance.Status.HostDefinerReady = found && r.isReady(deployment)
 	phase := csiv1.DriverPhaseCreating
-	if instance.Status.HostDefinerReady {
+	if instance.Status.HostDefinerReady || managementState == csiv1.ManagementStateRemoved {
 		phase = csiv1.DriverPhaseRunning
 	}
 	instance.Status.Phase = phase
-	instance.Status.Version = oversion.DriverVersion
 }
 
 func (r *HostDefinerReconciler) isReady(deployment *appsv1.Deployment) bool {
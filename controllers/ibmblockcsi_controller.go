@@ -21,6 +21,7 @@ import (
 	"fmt"
 	"os"
 	"reflect"
+	"sync"
 	"time"
 
 	"github.com/IBM/ibm-block-csi-operator/controllers/internal/crutils"
@@ -28,25 +29,32 @@ import (
 	pkg_errors "github.com/pkg/errors"
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
 	rbacv1 "k8s.io/api/rbac/v1"
 	storagev1 "k8s.io/api/storage/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/discovery"
 	"k8s.io/client-go/tools/record"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/client/config"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
 
 	csiv1 "github.com/IBM/ibm-block-csi-operator/api/v1"
 	clustersyncer "github.com/IBM/ibm-block-csi-operator/controllers/syncer"
 	oconfig "github.com/IBM/ibm-block-csi-operator/pkg/config"
 	kubeutil "github.com/IBM/ibm-block-csi-operator/pkg/util/kubernetes"
-	oversion "github.com/IBM/ibm-block-csi-operator/version"
 	"github.com/go-logr/logr"
 	"github.com/presslabs/controller-util/pkg/syncer"
 	"k8s.io/client-go/rest"
@@ -68,40 +76,58 @@ type IBMBlockCSIReconciler struct {
 	// This client, initialized using mgr.Client() above, is a split client
 	// that reads objects from the cache and writes to the apiserver
 	client.Client
-	Scheme           *runtime.Scheme
-	Namespace        string
-	Recorder         record.EventRecorder
-	ServerVersion    string
+	Scheme        *runtime.Scheme
+	Namespace     string
+	Recorder      record.EventRecorder
+	ServerVersion string
+	// APIReader reads the objects which are not in the namespaces of the cache
+	APIReader        client.Reader
 	ControllerHelper *common.ControllerHelper
+
+	serverVersionLock sync.RWMutex
 }
 
 // the rbac rule requires an empty row at the end to render
 // +kubebuilder:rbac:groups="",resources=pods,verbs=get;delete;list;watch
-// +kubebuilder:rbac:groups="",resources=configmaps,verbs=get;create;delete
+// +kubebuilder:rbac:groups="",resources=configmaps,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups="",resources=secrets,verbs=get;list;watch
+// +kubebuilder:rbac:groups="",namespace=default,resources=secrets,verbs=create;update;patch;delete
 // +kubebuilder:rbac:groups="",resources=persistentvolumeclaims,verbs=get;list;watch;update;patch
 // +kubebuilder:rbac:groups="",resources=persistentvolumeclaims/status,verbs=get;update;patch
 // +kubebuilder:rbac:groups="",resources=persistentvolumeclaims/finalizers,verbs=update
 // +kubebuilder:rbac:groups="",resources=persistentvolumes,verbs=get;delete;list;watch;update;create;patch
 // +kubebuilder:rbac:groups="",resources=events,verbs=*
-// +kubebuilder:rbac:groups="",resources=nodes,verbs=get;list;watch
-// +kubebuilder:rbac:groups=apps,resources=deployments;daemonsets;statefulsets,verbs=get;list;watch;update;create;delete
+// +kubebuilder:rbac:groups="",resources=nodes,verbs=get;list;watch;update;patch
+// +kubebuilder:rbac:groups="",resources=namespaces,verbs=get;list;watch;patch
+// +kubebuilder:rbac:groups=apps,resources=deployments;daemonsets;statefulsets,verbs=get;list;watch;update;patch;create;delete
 // +kubebuilder:rbac:groups="",resources=serviceaccounts,verbs=create;delete;get;watch;list
 // +kubebuilder:rbac:groups=rbac.authorization.k8s.io,resources=clusterroles;clusterrolebindings,verbs=create;delete;get;watch;list;update
 // +kubebuilder:rbac:groups=storage.k8s.io,resources=volumeattachments,verbs=get;list;watch;update;patch
 // +kubebuilder:rbac:groups=storage.k8s.io,resources=volumeattachments/status,verbs=patch
 // +kubebuilder:rbac:groups=storage.k8s.io,resources=storageclasses,verbs=get;list;watch
+// +kubebuilder:rbac:groups=discovery.k8s.io,resources=endpointslices,verbs=list
+// +kubebuilder:rbac:groups=networking.k8s.io,resources=networkpolicies,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=monitoring.coreos.com,resources=servicemonitors,verbs=get;create
 // +kubebuilder:rbac:groups=apps,resourceNames=ibm-block-csi-operator,resources=deployments/finalizers,verbs=update
-// +kubebuilder:rbac:groups=storage.k8s.io,resources=csidrivers,verbs=create;delete;get;watch;list
+// +kubebuilder:rbac:groups=storage.k8s.io,resources=csidrivers,verbs=create;delete;get;watch;list;update
 // +kubebuilder:rbac:groups=storage.k8s.io,resources=csinodes,verbs=get;list;watch
-// +kubebuilder:rbac:groups=security.openshift.io,resourceNames=anyuid;privileged,resources=securitycontextconstraints,verbs=use
+// +kubebuilder:rbac:groups=storage.k8s.io,resources=csistoragecapacities,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=apps,resources=replicasets,verbs=get
+// +kubebuilder:rbac:groups=security.openshift.io,resources=securitycontextconstraints,verbs=get;list;watch;create
+// +kubebuilder:rbac:groups=security.openshift.io,resourceNames=ibm-block-csi-node,resources=securitycontextconstraints,verbs=update;patch;delete;use
+// +kubebuilder:rbac:groups=security.openshift.io,resourceNames=anyuid,resources=securitycontextconstraints,verbs=use
+// +kubebuilder:rbac:groups=machineconfiguration.openshift.io,resources=machineconfigs,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=operators.coreos.com,resources=operatorconditions,verbs=get;list;watch;update;patch
+// +kubebuilder:rbac:groups=config.openshift.io,resources=proxies,verbs=get;list;watch
 // +kubebuilder:rbac:groups=apiextensions.k8s.io,resources=customresourcedefinitions,verbs=create;list;watch;delete
 // +kubebuilder:rbac:groups=csi.ibm.com,resources=*,verbs=*
 // +kubebuilder:rbac:groups=snapshot.storage.k8s.io,resources=volumesnapshotclasses,verbs=get;watch;list
 // +kubebuilder:rbac:groups=snapshot.storage.k8s.io,resources=volumesnapshotcontents,verbs=get;watch;list;create;update;delete
 // +kubebuilder:rbac:groups=snapshot.storage.k8s.io,resources=volumesnapshotcontents/status,verbs=update
 // +kubebuilder:rbac:groups=snapshot.storage.k8s.io,resources=volumesnapshots,verbs=get;watch;list;update
+// +kubebuilder:rbac:groups=groupsnapshot.storage.k8s.io,resources=volumegroupsnapshotclasses,verbs=get;watch;list
+// +kubebuilder:rbac:groups=groupsnapshot.storage.k8s.io,resources=volumegroupsnapshotcontents,verbs=get;watch;list;update;patch
+// +kubebuilder:rbac:groups=groupsnapshot.storage.k8s.io,resources=volumegroupsnapshotcontents/status,verbs=update;patch
 // +kubebuilder:rbac:groups=replication.storage.openshift.io,resources=volumereplicationclasses,verbs=get;list;watch
 // +kubebuilder:rbac:groups=replication.storage.openshift.io,resources=volumereplications,verbs=create;delete;get;list;patch;update;watch
 // +kubebuilder:rbac:groups=replication.storage.openshift.io,resources=volumereplications/finalizers,verbs=update
@@ -113,7 +139,7 @@ func (r *IBMBlockCSIReconciler) Reconcile(ctx context.Context, req ctrl.Request)
 	r.ControllerHelper.Log = log
 
 	// Fetch the IBMBlockCSI instance
-	instance := crutils.New(&csiv1.IBMBlockCSI{}, r.ServerVersion)
+	instance := crutils.New(&csiv1.IBMBlockCSI{}, r.getKnownServerVersion())
 	err := r.Get(context.TODO(), req.NamespacedName, instance.Unwrap())
 	if err != nil {
 		if errors.IsNotFound(err) {
@@ -130,6 +156,15 @@ func (r *IBMBlockCSIReconciler) Reconcile(ctx context.Context, req ctrl.Request)
 	changed := instance.SetDefaults()
 	if err := instance.Validate(); err != nil {
 		err = fmt.Errorf("wrong IBMBlockCSI options: %v", err)
+		originalStatus := *instance.Status.DeepCopy()
+		instance.SetDegradedCondition(err)
+		instance.SetUpgradeableCondition(nil, nil)
+		if sErr := r.writeStatus(instance, originalStatus); sErr != nil {
+			return reconcile.Result{}, sErr
+		}
+		if ocErr := r.reconcileOperatorCondition(); ocErr != nil {
+			return reconcile.Result{}, ocErr
+		}
 		return reconcile.Result{RequeueAfter: ReconcileTime}, err
 	}
 
@@ -147,6 +182,11 @@ func (r *IBMBlockCSIReconciler) Reconcile(ctx context.Context, req ctrl.Request)
 		return reconcile.Result{}, err
 	}
 
+	if instance.SecurityContextConstraintsAvailable, err = r.ControllerHelper.IsAPIAvailable(
+		crutils.SecurityContextConstraintsGroupVersionKind); err != nil {
+		return reconcile.Result{}, err
+	}
+
 	if !instance.GetDeletionTimestamp().IsZero() {
 		isFinalizerExists, err := r.ControllerHelper.HasFinalizer(instance)
 		if err != nil {
@@ -165,6 +205,14 @@ func (r *IBMBlockCSIReconciler) Reconcile(ctx context.Context, req ctrl.Request)
 			return reconcile.Result{}, err
 		}
 
+		if err := r.deleteMachineConfigs(instance, nil); err != nil {
+			return reconcile.Result{}, err
+		}
+
+		if err := r.deleteNodeSecurityContextConstraints(instance); err != nil {
+			return reconcile.Result{}, err
+		}
+
 		if err := r.ControllerHelper.RemoveFinalizer(
 			instance, instance.Unwrap()); err != nil {
 			return reconcile.Result{}, err
@@ -174,26 +222,60 @@ func (r *IBMBlockCSIReconciler) Reconcile(ctx context.Context, req ctrl.Request)
 
 	originalStatus := *instance.Status.DeepCopy()
 
+	if instance.IsReconcilePaused() {
+		reqLogger.Info("Reconciling IBMBlockCSI is paused", "annotation", oconfig.PauseReconcileAnnotation)
+		instance.Status.Paused = true
+		return reconcile.Result{}, r.writeStatus(instance, originalStatus)
+	}
+
+	if instance.ClusterProxy, err = getClusterProxy(r.Client); err != nil {
+		return reconcile.Result{}, err
+	}
+
 	// create the resources which never change if not exist
 	for _, rec := range []reconciler{
 		r.reconcileCSIDriver,
+		r.reconcileImagePullSecrets,
 		r.reconcileServiceAccount,
 		r.reconcileClusterRole,
 		r.reconcileClusterRoleBinding,
+		r.reconcileMachineConfigs,
+		r.reconcileTrustedCABundleConfigMap,
+		r.reconcilePodSecurityLabels,
+		r.reconcileNetworkPolicy,
 	} {
 		if err = rec(instance); err != nil {
 			return reconcile.Result{}, err
 		}
 	}
 
+	if err := r.setHostDefinerConnectivityType(instance); err != nil {
+		return reconcile.Result{}, err
+	}
+
+	if err := r.setPolicyConnectivityTypes(instance); err != nil {
+		return reconcile.Result{}, err
+	}
+
+	if err := r.setMissingSidecarAPIs(instance); err != nil {
+		return reconcile.Result{}, err
+	}
+
+	if instance.TrustedCABundleChecksum, err = getTrustedCABundleChecksum(r.Client,
+		instance.GetTrustedCAConfigMapName(), instance.Namespace); err != nil {
+		return reconcile.Result{}, err
+	}
+
 	// sync the resources which change over time
-	csiControllerSyncer := clustersyncer.NewCSIControllerSyncer(r.Client, r.Scheme, instance)
-	if err := syncer.Sync(context.TODO(), csiControllerSyncer, r.Recorder); err != nil {
+	if err := r.syncController(instance); err != nil {
 		return reconcile.Result{}, err
 	}
 
-	csiNodeSyncer := clustersyncer.NewCSINodeSyncer(r.Client, r.Scheme, instance, daemonSetRestartedKey, daemonSetRestartedValue)
-	if err := syncer.Sync(context.TODO(), csiNodeSyncer, r.Recorder); err != nil {
+	if err := r.reconcileNodeSecurityContextConstraints(instance); err != nil {
+		return reconcile.Result{}, err
+	}
+
+	if err := r.syncNode(instance); err != nil {
 		return reconcile.Result{}, err
 	}
 
@@ -201,6 +283,14 @@ func (r *IBMBlockCSIReconciler) Reconcile(ctx context.Context, req ctrl.Request)
 		return reconcile.Result{}, err
 	}
 
+	if err := r.reconcileOperatorCondition(); err != nil {
+		return reconcile.Result{}, err
+	}
+
+	if isImagePullSecretsResyncNeeded(instance.Spec.ImagePullSecretSources, instance.Status.MissingImagePullSecrets) {
+		return reconcile.Result{RequeueAfter: ReconcileTime}, nil
+	}
+
 	// Resource created successfully - don't requeue
 	return reconcile.Result{}, nil
 }
@@ -214,13 +304,139 @@ func (r *IBMBlockCSIReconciler) SetupWithManager(mgr ctrl.Manager) error {
 	}
 
 	log.Info(fmt.Sprintf("Kubernetes Version: %s", serverVersion))
+	r.setKnownServerVersion(serverVersion)
 
-	return ctrl.NewControllerManagedBy(mgr).
+	serverVersionEvents := make(chan event.GenericEvent)
+	if err := mgr.Add(manager.RunnableFunc(func(ctx context.Context) error {
+		return r.watchServerVersion(ctx, serverVersionEvents)
+	})); err != nil {
+		return err
+	}
+
+	controllerBuilder := ctrl.NewControllerManagedBy(mgr).
 		For(&csiv1.IBMBlockCSI{}).
 		Owns(&appsv1.StatefulSet{}).
 		Owns(&appsv1.DaemonSet{}).
 		Owns(&corev1.ServiceAccount{}).
-		Complete(r)
+		Owns(&corev1.ConfigMap{}).
+		Owns(&networkingv1.NetworkPolicy{}).
+		Watches(&csiv1.HostDefiner{}, handler.EnqueueRequestsFromMapFunc(r.getIBMBlockCSIRequestsInNamespace)).
+		Watches(&csiv1.NodeConnectivityPolicy{}, handler.EnqueueRequestsFromMapFunc(r.getIBMBlockCSIRequests),
+			builder.WithPredicates(predicate.GenerationChangedPredicate{})).
+		Watches(&corev1.ConfigMap{}, handler.EnqueueRequestsFromMapFunc(r.getIBMBlockCSIRequestsOfTrustedCA)).
+		Watches(&storagev1.StorageClass{}, handler.EnqueueRequestsFromMapFunc(r.getIBMBlockCSIRequests),
+			builder.WithPredicates(predicate.NewPredicateFuncs(isDriverStorageClass))).
+		Watches(newCustomResourceDefinitionMetadata(), handler.EnqueueRequestsFromMapFunc(r.getIBMBlockCSIRequests),
+			builder.WithPredicates(predicate.NewPredicateFuncs(isSidecarCustomResourceDefinition))).
+		WatchesRawSource(source.Channel(serverVersionEvents, &handler.EnqueueRequestForObject{}))
+
+	clusterProxyAvailable, err := r.ControllerHelper.IsAPIAvailable(clusterProxyGroupVersionKind)
+	if err != nil {
+		return err
+	}
+	if clusterProxyAvailable {
+		controllerBuilder = controllerBuilder.Watches(newClusterProxyMetadata(), handler.EnqueueRequestsFromMapFunc(r.getIBMBlockCSIRequests))
+	}
+	return controllerBuilder.Complete(r)
+}
+
+// getIBMBlockCSIRequestsInNamespace returns reconcile requests for all the IBMBlockCSIs in the namespace of obj
+func (r *IBMBlockCSIReconciler) getIBMBlockCSIRequestsInNamespace(ctx context.Context, obj client.Object) []reconcile.Request {
+	return r.listIBMBlockCSIRequests(ctx, client.InNamespace(obj.GetNamespace()))
+}
+
+// getIBMBlockCSIRequests returns reconcile requests for all the IBMBlockCSIs
+func (r *IBMBlockCSIReconciler) getIBMBlockCSIRequests(ctx context.Context, _ client.Object) []reconcile.Request {
+	return r.listIBMBlockCSIRequests(ctx)
+}
+
+func (r *IBMBlockCSIReconciler) listIBMBlockCSIRequests(ctx context.Context, opts ...client.ListOption) []reconcile.Request {
+	ibmBlockCSIs := &csiv1.IBMBlockCSIList{}
+	if err := r.List(ctx, ibmBlockCSIs, opts...); err != nil {
+		log.Error(err, "failed to list IBMBlockCSIs")
+		return nil
+	}
+
+	var requests []reconcile.Request
+	for _, ibmBlockCSI := range ibmBlockCSIs.Items {
+		requests = append(requests, reconcile.Request{NamespacedName: types.NamespacedName{
+			Name:      ibmBlockCSI.Name,
+			Namespace: ibmBlockCSI.Namespace,
+		}})
+	}
+	return requests
+}
+
+// setHostDefinerConnectivityType sets the connectivity type of the HostDefiner in the namespace on the instance
+func (r *IBMBlockCSIReconciler) setHostDefinerConnectivityType(instance *crutils.IBMBlockCSI) error {
+	hostDefiners := &csiv1.HostDefinerList{}
+	if err := r.List(context.TODO(), hostDefiners, client.InNamespace(instance.Namespace)); err != nil {
+		return err
+	}
+
+	for _, hostDefiner := range hostDefiners.Items {
+		if hostDefiner.Spec.HostDefiner.ConnectivityType != "" {
+			instance.HostDefinerConnectivityType = csiv1.ConnectivityType(hostDefiner.Spec.HostDefiner.ConnectivityType)
+			return nil
+		}
+	}
+	return nil
+}
+
+func (r *IBMBlockCSIReconciler) syncController(instance *crutils.IBMBlockCSI) error {
+	logger := log.WithValues("Resource Type", "StatefulSet")
+
+	switch instance.GetControllerManagementState() {
+	case csiv1.ManagementStateUnmanaged:
+		logger.Info("Skip sync: controller is unmanaged")
+		return nil
+	case csiv1.ManagementStateRemoved:
+		controllerStatefulset, err := r.getControllerStatefulSet(instance)
+		if errors.IsNotFound(err) {
+			instance.Status.ControllerOverridesHash = ""
+			return nil
+		} else if err != nil {
+			return err
+		}
+		logger.Info("deleting controller StatefulSet", "Name", controllerStatefulset.GetName())
+		instance.Status.ControllerOverridesHash = ""
+		return r.Delete(context.TODO(), controllerStatefulset)
+	}
+
+	csiControllerSyncer := clustersyncer.NewCSIControllerSyncer(r.Client, r.Scheme, instance)
+	if err := syncer.Sync(context.TODO(), csiControllerSyncer, r.Recorder); err != nil {
+		return err
+	}
+	instance.Status.ControllerOverridesHash = instance.GetControllerOverridesHash()
+	return nil
+}
+
+func (r *IBMBlockCSIReconciler) syncNode(instance *crutils.IBMBlockCSI) error {
+	logger := log.WithValues("Resource Type", "DaemonSet")
+
+	switch instance.GetNodeManagementState() {
+	case csiv1.ManagementStateUnmanaged:
+		logger.Info("Skip sync: node is unmanaged")
+		return nil
+	case csiv1.ManagementStateRemoved:
+		nodeDaemonSet, err := r.getNodeDaemonSet(instance)
+		if errors.IsNotFound(err) {
+			instance.Status.NodeOverridesHash = ""
+			return nil
+		} else if err != nil {
+			return err
+		}
+		logger.Info("deleting node DaemonSet", "Name", nodeDaemonSet.GetName())
+		instance.Status.NodeOverridesHash = ""
+		return r.Delete(context.TODO(), nodeDaemonSet)
+	}
+
+	csiNodeSyncer := clustersyncer.NewCSINodeSyncer(r.Client, r.Scheme, instance, daemonSetRestartedKey, daemonSetRestartedValue)
+	if err := syncer.Sync(context.TODO(), csiNodeSyncer, r.Recorder); err != nil {
+		return err
+	}
+	instance.Status.NodeOverridesHash = instance.GetNodeOverridesHash()
+	return nil
 }
 
 func getServerVersion() (string, error) {
@@ -263,23 +479,34 @@ func composeServerVersion(client discovery.DiscoveryInterface) (string, error) {
 func (r *IBMBlockCSIReconciler) updateStatus(instance *crutils.IBMBlockCSI, originalStatus csiv1.IBMBlockCSIStatus) error {
 	logger := log.WithName("updateStatus")
 	controllerPod := &corev1.Pod{}
+	controllerManagementState := instance.GetControllerManagementState()
+	nodeManagementState := instance.GetNodeManagementState()
+
 	controllerStatefulset, err := r.getControllerStatefulSet(instance)
+	controllerFound, err := isWorkloadFound(err, controllerManagementState)
 	if err != nil {
 		return err
 	}
 
 	nodeDaemonSet, err := r.getNodeDaemonSet(instance)
+	nodeFound, err := isWorkloadFound(err, nodeManagementState)
 	if err != nil {
 		return err
 	}
 
-	instance.Status.ControllerReady = r.isControllerReady(controllerStatefulset)
-	instance.Status.NodeReady = r.isNodeReady(nodeDaemonSet)
+	instance.Status.Paused = false
+	instance.Status.ControllerManagementState = controllerManagementState
+	instance.Status.NodeManagementState = nodeManagementState
+	instance.Status.ControllerReady = controllerFound && r.isControllerReady(controllerStatefulset)
+	instance.Status.NodeReady = nodeFound && r.isNodeReady(nodeDaemonSet)
+
+	controllerAvailable := instance.Status.ControllerReady || controllerManagementState == csiv1.ManagementStateRemoved
+	nodeAvailable := instance.Status.NodeReady || nodeManagementState == csiv1.ManagementStateRemoved
 	phase := csiv1.DriverPhaseNone
-	if instance.Status.ControllerReady && instance.Status.NodeReady {
+	if controllerAvailable && nodeAvailable {
 		phase = csiv1.DriverPhaseRunning
 	} else {
-		if !instance.Status.ControllerReady {
+		if !controllerAvailable && controllerManagementState == csiv1.ManagementStateManaged {
 			err := r.getControllerPod(controllerStatefulset, controllerPod)
 			if err != nil {
 				logger.Error(err, "failed to get controller pod")
@@ -293,7 +520,37 @@ func (r *IBMBlockCSIReconciler) updateStatus(instance *crutils.IBMBlockCSI, orig
 		phase = csiv1.DriverPhaseCreating
 	}
 	instance.Status.Phase = phase
-	instance.Status.Version = oversion.DriverVersion
+
+	controllerPods, err := listPods(r.Client, instance.GetCSIControllerSelectorLabels(), instance.Namespace)
+	if err != nil {
+		logger.Error(err, "failed to list controller pods")
+		return err
+	}
+	nodePods, err := listPods(r.Client, instance.GetCSINodeSelectorLabels(), instance.Namespace)
+	if err != nil {
+		logger.Error(err, "failed to list node pods")
+		return err
+	}
+	instance.SetComponentVersions(controllerPods, nodePods)
+
+	if !controllerFound {
+		controllerStatefulset = nil
+	}
+	if !nodeFound {
+		nodeDaemonSet = nil
+	}
+	instance.SetNodePluginStatus(nodeDaemonSet, nodePods)
+	instance.SetNodePrerequisitesCondition(nodePods)
+	instance.SetSidecarAPIsCondition()
+
+	instance.SetDegradedCondition(nil)
+	instance.SetUpgradeableCondition(controllerStatefulset, nodeDaemonSet)
+
+	return r.writeStatus(instance, originalStatus)
+}
+
+func (r *IBMBlockCSIReconciler) writeStatus(instance *crutils.IBMBlockCSI, originalStatus csiv1.IBMBlockCSIStatus) error {
+	logger := log.WithName("writeStatus")
 
 	if !reflect.DeepEqual(originalStatus, instance.Status) {
 		logger.Info("updating IBMBlockCSI status", "name", instance.Name, "from", originalStatus, "to", instance.Status)
@@ -306,6 +563,17 @@ func (r *IBMBlockCSIReconciler) updateStatus(instance *crutils.IBMBlockCSI, orig
 	return nil
 }
 
+// isWorkloadFound tells if a workload was found, a missing workload is an error only if it is managed
+func isWorkloadFound(getErr error, managementState csiv1.ManagementState) (bool, error) {
+	if getErr == nil {
+		return true, nil
+	}
+	if errors.IsNotFound(getErr) && managementState != csiv1.ManagementStateManaged {
+		return false, nil
+	}
+	return false, getErr
+}
+
 func (r *IBMBlockCSIReconciler) areAllPodImagesSynced(controllerStatefulset *appsv1.StatefulSet, controllerPod *corev1.Pod) bool {
 	logger := log.WithName("areAllPodImagesSynced")
 	statefulSetContainers := controllerStatefulset.Spec.Template.Spec.Containers
@@ -396,13 +664,36 @@ func (r *IBMBlockCSIReconciler) reconcileCSIDriver(instance *crutils.IBMBlockCSI
 	} else if err != nil {
 		logger.Error(err, "Failed to get CSIDriver", "Name", cd.GetName())
 		return err
-	} else {
-		// Resource already exists - don't requeue
+	} else if crutils.IsCSIDriverChanged(found, cd) {
+		if instance.IsCSIDriverRecreateRequired(found, cd) {
+			return r.recreateCSIDriver(instance, found, cd)
+		}
+		logger.Info("Updating the CSIDriver", "Name", cd.GetName())
+		found.Spec = cd.Spec
+		return r.Update(context.TODO(), found)
 	}
 
 	return nil
 }
 
+// recreateCSIDriver deletes the CSIDriver and creates the desired one, to change its immutable fields.
+// The deletion is conditioned on the UID, so that a CSIDriver created meanwhile is not deleted.
+func (r *IBMBlockCSIReconciler) recreateCSIDriver(instance *crutils.IBMBlockCSI, current, desired *storagev1.CSIDriver) error {
+	logger := log.WithValues("Resource Type", "CSIDriver")
+
+	logger.Info("Recreating the CSIDriver to change its immutable fields", "Name", desired.GetName())
+	uid := current.GetUID()
+	if err := r.Delete(context.TODO(), current, client.Preconditions{UID: &uid}); err != nil && !errors.IsNotFound(err) {
+		return err
+	}
+	if err := r.Create(context.TODO(), desired); err != nil {
+		return err
+	}
+	r.Recorder.Event(instance.Unwrap(), corev1.EventTypeNormal, "CSIDriverRecreated",
+		fmt.Sprintf("recreated the CSIDriver %s to change its immutable fields", desired.GetName()))
+	return nil
+}
+
 func (r *IBMBlockCSIReconciler) reconcileServiceAccount(instance *crutils.IBMBlockCSI) error {
 	logger := log.WithValues("Resource Type", "ServiceAccount")
 
@@ -459,9 +750,8 @@ func (r *IBMBlockCSIReconciler) reconcileServiceAccount(instance *crutils.IBMBlo
 		} else if err != nil {
 			logger.Error(err, "Failed to get ServiceAccount", "Name", sa.GetName())
 			return err
-		} else {
-			// Resource already exists - don't requeue
-			//logger.Info("Skip reconcile: ServiceAccount already exists", "Namespace", sa.GetNamespace(), "Name", sa.GetName())
+		} else if err := updateServiceAccountImagePullSecrets(r.Client, found, instance.GetImagePullSecrets()); err != nil {
+			return err
 		}
 	}
 
@@ -498,6 +788,12 @@ func (r *IBMBlockCSIReconciler) getNodeDaemonSet(instance *crutils.IBMBlockCSI)
 	return node, err
 }
 
+func listPods(c client.Client, selectorLabels map[string]string, namespace string) ([]corev1.Pod, error) {
+	pods := &corev1.PodList{}
+	err := c.List(context.TODO(), pods, client.InNamespace(namespace), client.MatchingLabels(selectorLabels))
+	return pods.Items, err
+}
+
 func (r *IBMBlockCSIReconciler) isControllerReady(controller *appsv1.StatefulSet) bool {
 	return controller.Status.ReadyReplicas == controller.Status.Replicas
 }
@@ -506,9 +802,17 @@ func (r *IBMBlockCSIReconciler) isNodeReady(node *appsv1.DaemonSet) bool {
 	return node.Status.DesiredNumberScheduled == node.Status.NumberAvailable
 }
 
+// reconcileClusterRole reconciles the ClusterRoles of the driver, and deletes the ClusterRoles which use
+// SecurityContextConstraints when the cluster does not serve them
 func (r *IBMBlockCSIReconciler) reconcileClusterRole(instance *crutils.IBMBlockCSI) error {
 	clusterRoles := r.getClusterRoles(instance)
-	return r.ControllerHelper.ReconcileClusterRole(clusterRoles)
+	if err := r.ControllerHelper.ReconcileClusterRole(clusterRoles); err != nil {
+		return err
+	}
+	if instance.SecurityContextConstraintsAvailable {
+		return nil
+	}
+	return r.ControllerHelper.DeleteClusterRoles(instance.GenerateSCCClusterRoles())
 }
 
 func (r *IBMBlockCSIReconciler) deleteClusterRolesAndBindings(instance *crutils.IBMBlockCSI) error {
@@ -528,30 +832,20 @@ func (r *IBMBlockCSIReconciler) deleteClusterRoles(instance *crutils.IBMBlockCSI
 }
 
 func (r *IBMBlockCSIReconciler) getClusterRoles(instance *crutils.IBMBlockCSI) []*rbacv1.ClusterRole {
-	externalProvisioner := instance.GenerateExternalProvisionerClusterRole()
-	externalAttacher := instance.GenerateExternalAttacherClusterRole()
-	externalSnapshotter := instance.GenerateExternalSnapshotterClusterRole()
-	externalResizer := instance.GenerateExternalResizerClusterRole()
-	csiAddonsReplicator := instance.GenerateCSIAddonsReplicatorClusterRole()
-	volumeGroup := instance.GenerateVolumeGroupClusterRole()
-	controllerSCC := instance.GenerateSCCForControllerClusterRole()
-	nodeSCC := instance.GenerateSCCForNodeClusterRole()
-
-	return []*rbacv1.ClusterRole{
-		externalProvisioner,
-		externalAttacher,
-		externalSnapshotter,
-		externalResizer,
-		csiAddonsReplicator,
-		volumeGroup,
-		controllerSCC,
-		nodeSCC,
-	}
+	return instance.GenerateClusterRoles()
 }
 
+// reconcileClusterRoleBinding reconciles the ClusterRoleBindings of the driver, and deletes the ClusterRoleBindings
+// of the ClusterRoles which use SecurityContextConstraints when the cluster does not serve them
 func (r *IBMBlockCSIReconciler) reconcileClusterRoleBinding(instance *crutils.IBMBlockCSI) error {
 	clusterRoleBindings := r.getClusterRoleBindings(instance)
-	return r.ControllerHelper.ReconcileClusterRoleBinding(clusterRoleBindings)
+	if err := r.ControllerHelper.ReconcileClusterRoleBinding(clusterRoleBindings); err != nil {
+		return err
+	}
+	if instance.SecurityContextConstraintsAvailable {
+		return nil
+	}
+	return r.ControllerHelper.DeleteClusterRoleBindings(instance.GenerateSCCClusterRoleBindings())
 }
 
 func (r *IBMBlockCSIReconciler) deleteClusterRoleBindings(instance *crutils.IBMBlockCSI) error {
@@ -560,25 +854,7 @@ func (r *IBMBlockCSIReconciler) deleteClusterRoleBindings(instance *crutils.IBMB
 }
 
 func (r *IBMBlockCSIReconciler) getClusterRoleBindings(instance *crutils.IBMBlockCSI) []*rbacv1.ClusterRoleBinding {
-	externalProvisioner := instance.GenerateExternalProvisionerClusterRoleBinding()
-	externalAttacher := instance.GenerateExternalAttacherClusterRoleBinding()
-	externalSnapshotter := instance.GenerateExternalSnapshotterClusterRoleBinding()
-	externalResizer := instance.GenerateExternalResizerClusterRoleBinding()
-	csiAddonsReplicator := instance.GenerateCSIAddonsReplicatorClusterRoleBinding()
-	volumeGroup := instance.GenerateVolumeGroupClusterRoleBinding()
-	controllerSCC := instance.GenerateSCCForControllerClusterRoleBinding()
-	nodeSCC := instance.GenerateSCCForNodeClusterRoleBinding()
-
-	return []*rbacv1.ClusterRoleBinding{
-		externalProvisioner,
-		externalAttacher,
-		externalSnapshotter,
-		externalResizer,
-		csiAddonsReplicator,
-		volumeGroup,
-		controllerSCC,
-		nodeSCC,
-	}
+	return instance.GenerateClusterRoleBindings()
 }
 
 func (r *IBMBlockCSIReconciler) deleteCSIDriver(instance *crutils.IBMBlockCSI) error {
@@ -604,3 +880,62 @@ func (r *IBMBlockCSIReconciler) deleteCSIDriver(instance *crutils.IBMBlockCSI) e
 	}
 	return nil
 }
+
+func (r *IBMBlockCSIReconciler) reconcileMachineConfigs(instance *crutils.IBMBlockCSI) error {
+	logger := log.WithValues("Resource Type", "MachineConfig")
+
+	available, err := r.ControllerHelper.IsAPIAvailable(crutils.MachineConfigGroupVersionKind)
+	if err != nil {
+		return err
+	}
+	if !available {
+		if instance.Spec.HostPrerequisites != nil {
+			logger.Info("Skip reconcile: the MachineConfig API is not available in the cluster")
+			r.Recorder.Event(instance.Unwrap(), corev1.EventTypeWarning, "MachineConfigNotAvailable",
+				"hostPrerequisites is ignored since the MachineConfig API is not available in the cluster")
+		}
+		return nil
+	}
+
+	machineConfigNames := map[string]bool{}
+	for _, machineConfig := range instance.GenerateMachineConfigs() {
+		machineConfigNames[machineConfig.GetName()] = true
+		if err := r.Patch(context.TODO(), machineConfig, client.Apply,
+			client.FieldOwner(oconfig.FieldManager), client.ForceOwnership); err != nil {
+			logger.Error(err, "Failed to apply MachineConfig", "Name", machineConfig.GetName())
+			return err
+		}
+	}
+	return r.deleteMachineConfigs(instance, machineConfigNames)
+}
+
+// deleteMachineConfigs deletes the MachineConfigs of the IBMBlockCSI which are not in machineConfigNamesToKeep
+func (r *IBMBlockCSIReconciler) deleteMachineConfigs(instance *crutils.IBMBlockCSI, machineConfigNamesToKeep map[string]bool) error {
+	logger := log.WithName("deleteMachineConfigs")
+
+	available, err := r.ControllerHelper.IsAPIAvailable(crutils.MachineConfigGroupVersionKind)
+	if err != nil || !available {
+		return err
+	}
+
+	machineConfigs := &unstructured.UnstructuredList{}
+	machineConfigs.SetGroupVersionKind(crutils.MachineConfigGroupVersionKind.GroupVersion().WithKind(
+		crutils.MachineConfigGroupVersionKind.Kind + "List"))
+	if err := r.List(context.TODO(), machineConfigs,
+		client.MatchingLabels(instance.GetMachineConfigSelectorLabels())); err != nil {
+		logger.Error(err, "failed to list MachineConfigs")
+		return err
+	}
+	for i := range machineConfigs.Items {
+		machineConfig := &machineConfigs.Items[i]
+		if machineConfigNamesToKeep[machineConfig.GetName()] {
+			continue
+		}
+		logger.Info("deleting MachineConfig", "Name", machineConfig.GetName())
+		if err := r.Delete(context.TODO(), machineConfig); err != nil && !errors.IsNotFound(err) {
+			logger.Error(err, "failed to delete MachineConfig", "Name", machineConfig.GetName())
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,177 @@
+/**
+ * Copyright 2025 IBM Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+
+	csiv1 "github.com/IBM/ibm-block-csi-operator/api/v1"
+	"github.com/IBM/ibm-block-csi-operator/controllers/internal/common"
+	"github.com/IBM/ibm-block-csi-operator/controllers/internal/crutils"
+	"github.com/IBM/ibm-block-csi-operator/controllers/internal/hostdefiner"
+	oconfig "github.com/IBM/ibm-block-csi-operator/pkg/config"
+)
+
+type imagePullSecretCopyGenerator func(source csiv1.ImagePullSecretSource, sourceSecret *corev1.Secret) *corev1.Secret
+
+// syncImagePullSecrets applies the copies of the pull secrets of the sources, deletes the copies of the sources
+// which were removed or are invalid, and returns the pull secrets which do not exist and the sources which are
+// invalid. The Secrets are read with the API reader, since the sources are not in the namespaces of the cache
+// and the Secrets are not cached. The copy of a source which does not exist anymore is kept
+func syncImagePullSecrets(c client.Client, apiReader client.Reader, scheme *runtime.Scheme, owner client.Object,
+	imagePullSecrets []string, sources []csiv1.ImagePullSecretSource,
+	generateCopy imagePullSecretCopyGenerator) ([]string, []string, error) {
+	logger := log.WithValues("Resource Type", "Secret")
+
+	var missing []string
+	for _, name := range imagePullSecrets {
+		err := apiReader.Get(context.TODO(), types.NamespacedName{Name: name, Namespace: owner.GetNamespace()},
+			&corev1.Secret{})
+		if errors.IsNotFound(err) {
+			missing = append(missing, name)
+		} else if err != nil {
+			return nil, nil, err
+		}
+	}
+
+	var invalid []string
+	copyNames := sets.New[string]()
+	for _, source := range sources {
+		copyName := common.GetImagePullSecretCopyName(source, owner.GetName())
+		sourceSecret := &corev1.Secret{}
+		if err := apiReader.Get(context.TODO(), types.NamespacedName{Name: source.Name, Namespace: source.Namespace},
+			sourceSecret); err != nil {
+			if errors.IsNotFound(err) {
+				copyNames.Insert(copyName)
+				missing = append(missing, fmt.Sprintf("%s/%s", source.Namespace, source.Name))
+				continue
+			}
+			return nil, nil, err
+		}
+		if err := common.ValidateImagePullSecretSource(sourceSecret); err != nil {
+			logger.Info("not copying an invalid pull secret source", "Reason", err.Error())
+			invalid = append(invalid, fmt.Sprintf("%s/%s", source.Namespace, source.Name))
+			continue
+		}
+		copyNames.Insert(copyName)
+
+		secretCopy := generateCopy(source, sourceSecret)
+		if err := controllerutil.SetControllerReference(owner, secretCopy, scheme); err != nil {
+			return nil, nil, err
+		}
+		if err := c.Patch(context.TODO(), secretCopy, client.Apply,
+			client.FieldOwner(oconfig.FieldManager), client.ForceOwnership); err != nil {
+			logger.Error(err, "Failed to apply the copy of a pull secret", "Name", secretCopy.Name)
+			return nil, nil, err
+		}
+	}
+
+	copies := &corev1.SecretList{}
+	if err := apiReader.List(context.TODO(), copies, client.InNamespace(owner.GetNamespace()),
+		client.HasLabels{oconfig.ImagePullSecretSourceLabel}); err != nil {
+		return nil, nil, err
+	}
+	for i := range copies.Items {
+		secretCopy := &copies.Items[i]
+		if !metav1.IsControlledBy(secretCopy, owner) || copyNames.Has(secretCopy.Name) {
+			continue
+		}
+		logger.Info("deleting the copy of a removed or invalid pull secret source", "Name", secretCopy.Name)
+		if err := c.Delete(context.TODO(), secretCopy); err != nil && !errors.IsNotFound(err) {
+			return nil, nil, err
+		}
+	}
+	return missing, invalid, nil
+}
+
+// updateServiceAccountImagePullSecrets updates the pull secrets of an existing ServiceAccount if they changed
+func updateServiceAccountImagePullSecrets(c client.Client, serviceAccount *corev1.ServiceAccount,
+	imagePullSecrets []string) error {
+	if !common.SetServiceAccountImagePullSecrets(serviceAccount, imagePullSecrets) {
+		return nil
+	}
+	log.Info("Updating the pull secrets of the ServiceAccount", "Name", serviceAccount.Name)
+	return c.Update(context.TODO(), serviceAccount)
+}
+
+// warnMissingImagePullSecrets records a warning event on the owner when pull secrets do not exist
+func warnMissingImagePullSecrets(recorder record.EventRecorder, owner runtime.Object, missing []string) {
+	if len(missing) == 0 {
+		return
+	}
+	recorder.Event(owner, corev1.EventTypeWarning, "ImagePullSecretMissing",
+		fmt.Sprintf("the pull secrets %s do not exist", strings.Join(missing, ", ")))
+}
+
+// warnInvalidImagePullSecrets records a warning event on the owner when pull secret sources are not copied
+func warnInvalidImagePullSecrets(recorder record.EventRecorder, owner runtime.Object, invalid []string) {
+	if len(invalid) == 0 {
+		return
+	}
+	recorder.Event(owner, corev1.EventTypeWarning, "ImagePullSecretInvalid",
+		fmt.Sprintf("the pull secrets %s are not copied, since they are not of the %s or %s type "+
+			"or do not have the %s=true label", strings.Join(invalid, ", "), corev1.SecretTypeDockerConfigJson,
+			corev1.SecretTypeDockercfg, oconfig.ImagePullSecretShareableLabel))
+}
+
+// isImagePullSecretsResyncNeeded returns true if the pull secrets must be resynced periodically,
+// since neither the sources nor the missing Secrets are watched
+func isImagePullSecretsResyncNeeded(sources []csiv1.ImagePullSecretSource, missing []string) bool {
+	return len(sources) > 0 || len(missing) > 0
+}
+
+// reconcileImagePullSecrets syncs the copies of the pull secrets of the sources and reports the missing
+// and invalid pull secrets
+func (r *IBMBlockCSIReconciler) reconcileImagePullSecrets(instance *crutils.IBMBlockCSI) error {
+	missing, invalid, err := syncImagePullSecrets(r.Client, r.APIReader, r.Scheme, instance.Unwrap(),
+		instance.Spec.ImagePullSecrets, instance.Spec.ImagePullSecretSources, instance.GenerateImagePullSecretCopy)
+	if err != nil {
+		return err
+	}
+	instance.Status.MissingImagePullSecrets = missing
+	instance.Status.InvalidImagePullSecrets = invalid
+	warnMissingImagePullSecrets(r.Recorder, instance.Unwrap(), missing)
+	warnInvalidImagePullSecrets(r.Recorder, instance.Unwrap(), invalid)
+	return nil
+}
+
+// reconcileImagePullSecrets syncs the copies of the pull secrets of the sources and reports the missing
+// and invalid pull secrets
+func (r *HostDefinerReconciler) reconcileImagePullSecrets(instance *hostdefiner.HostDefiner) error {
+	missing, invalid, err := syncImagePullSecrets(r.Client, r.APIReader, r.Scheme, instance.Unwrap(),
+		instance.Spec.ImagePullSecrets, instance.Spec.ImagePullSecretSources, instance.GenerateImagePullSecretCopy)
+	if err != nil {
+		return err
+	}
+	instance.Status.MissingImagePullSecrets = missing
+	instance.Status.InvalidImagePullSecrets = invalid
+	warnMissingImagePullSecrets(r.Recorder, instance.Unwrap(), missing)
+	warnInvalidImagePullSecrets(r.Recorder, instance.Unwrap(), invalid)
+	return nil
+}
@@ -0,0 +1,123 @@
+/**
+ * Copyright 2025 IBM Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package common
+
+import (
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	csiv1 "github.com/IBM/ibm-block-csi-operator/api/v1"
+	"github.com/IBM/ibm-block-csi-operator/pkg/config"
+)
+
+// ValidateArraySecret checks the schema of a storage array Secret: a list of management addresses, a username,
+// and a password or an SSH private key, of the array of the Secret or of each array of its config
+func ValidateArraySecret(secret *corev1.Secret) error {
+	arraysConfig, found := secret.Data[config.ArraySecretConfigKey]
+	if !found {
+		credentials := map[string]string{}
+		for key, value := range secret.Data {
+			credentials[key] = string(value)
+		}
+		return validateArrayCredentials(credentials)
+	}
+
+	arrays := map[string]map[string]string{}
+	if err := json.Unmarshal(arraysConfig, &arrays); err != nil {
+		return fmt.Errorf("%s is not a JSON object of the arrays: %v", config.ArraySecretConfigKey, err)
+	}
+	if len(arrays) == 0 {
+		return fmt.Errorf("%s has no arrays", config.ArraySecretConfigKey)
+	}
+	var arrayIDs []string
+	for arrayID := range arrays {
+		arrayIDs = append(arrayIDs, arrayID)
+	}
+	sort.Strings(arrayIDs)
+	for _, arrayID := range arrayIDs {
+		if err := validateArrayCredentials(arrays[arrayID]); err != nil {
+			return fmt.Errorf("%s of array %s: %v", config.ArraySecretConfigKey, arrayID, err)
+		}
+	}
+	return nil
+}
+
+func validateArrayCredentials(credentials map[string]string) error {
+	if len(splitAddresses(credentials[config.ArraySecretManagementAddressKey])) == 0 {
+		return fmt.Errorf("%s is missing", config.ArraySecretManagementAddressKey)
+	}
+	if strings.TrimSpace(credentials[config.ArraySecretUsernameKey]) == "" {
+		return fmt.Errorf("%s is missing", config.ArraySecretUsernameKey)
+	}
+	if credentials[config.ArraySecretPasswordKey] == "" && credentials[config.ArraySecretSSHPrivateKeyKey] == "" {
+		return fmt.Errorf("%s or %s is missing", config.ArraySecretPasswordKey, config.ArraySecretSSHPrivateKeyKey)
+	}
+	return nil
+}
+
+// GetArraySecretVersion returns the version of a storage array Secret, its UID and resource version.
+// It changes when the credentials are rotated, without revealing anything of them
+func GetArraySecretVersion(secret *corev1.Secret) string {
+	return fmt.Sprintf("%s-%s", secret.UID, secret.ResourceVersion)
+}
+
+// GetArraySecretsChecksum returns the checksum of the versions of the storage array Secrets which are referenced,
+// empty if there are none
+func GetArraySecretsChecksum(secrets []corev1.Secret, referenced sets.Set[types.NamespacedName]) string {
+	var versions []string
+	for i := range secrets {
+		if !referenced.Has(types.NamespacedName{Name: secrets[i].Name, Namespace: secrets[i].Namespace}) {
+			continue
+		}
+		versions = append(versions, fmt.Sprintf("%s/%s:%s",
+			secrets[i].Namespace, secrets[i].Name, GetArraySecretVersion(&secrets[i])))
+	}
+	if len(versions) == 0 {
+		return ""
+	}
+	sort.Strings(versions)
+	return fmt.Sprintf("%x", sha256.Sum256([]byte(strings.Join(versions, ","))))
+}
+
+// SetHostDefinitionArraySecretAnnotations annotates a HostDefinition with the version and the validity
+// of its array Secret, it returns true if the annotations changed
+func SetHostDefinitionArraySecretAnnotations(hostDefinition *csiv1.HostDefinition, version string, valid bool) bool {
+	annotations := map[string]string{
+		config.ArraySecretVersionAnnotation: version,
+		config.ArraySecretValidAnnotation:   strconv.FormatBool(valid),
+	}
+	changed := false
+	for key, value := range annotations {
+		if hostDefinition.Annotations[key] == value {
+			continue
+		}
+		if hostDefinition.Annotations == nil {
+			hostDefinition.Annotations = map[string]string{}
+		}
+		hostDefinition.Annotations[key] = value
+		changed = true
+	}
+	return changed
+}
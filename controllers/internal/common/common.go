@@ -16,10 +16,161 @@
 
 package common
 
-import "k8s.io/apimachinery/pkg/labels"
+import (
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	csiv1 "github.com/IBM/ibm-block-csi-operator/api/v1"
+	"github.com/IBM/ibm-block-csi-operator/controllers/util"
+	"github.com/IBM/ibm-block-csi-operator/pkg/config"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+)
 
 func GetSelectorLabels(component string) labels.Set {
 	return labels.Set{
 		"app.kubernetes.io/component": component,
 	}
 }
+
+// IsReconcilePaused returns true if the pause annotation is set to true
+func IsReconcilePaused(annotations map[string]string) bool {
+	paused, err := strconv.ParseBool(annotations[config.PauseReconcileAnnotation])
+	return err == nil && paused
+}
+
+// GetManagementState returns the first management state which is set, Managed if none is set
+func GetManagementState(states ...csiv1.ManagementState) csiv1.ManagementState {
+	for _, state := range states {
+		if state != "" {
+			return state
+		}
+	}
+	return csiv1.ManagementStateManaged
+}
+
+// ValidateOverrides checks that the overrides of a pod template are a JSON object
+func ValidateOverrides(overrides *runtime.RawExtension) error {
+	if overrides == nil || len(overrides.Raw) == 0 {
+		return nil
+	}
+	patch := map[string]interface{}{}
+	if err := json.Unmarshal(overrides.Raw, &patch); err != nil {
+		return fmt.Errorf("overrides must be a pod template patch object: %v", err)
+	}
+	return nil
+}
+
+// GetOverridesHash returns the hash of the overrides of a pod template, empty if there are no overrides
+func GetOverridesHash(overrides *runtime.RawExtension) string {
+	if overrides == nil || len(overrides.Raw) == 0 {
+		return ""
+	}
+	return fmt.Sprintf("%x", sha256.Sum256(overrides.Raw))
+}
+
+// GetImageVersion returns the tag of an image, empty if the image has no tag
+func GetImageVersion(image string) string {
+	image = strings.SplitN(image, "@", 2)[0]
+	tagIndex := strings.LastIndex(image, ":")
+	if tagIndex == -1 || tagIndex < strings.LastIndex(image, "/") {
+		return ""
+	}
+	return image[tagIndex+1:]
+}
+
+// GetComponentVersions returns the images the containers of the component pods run,
+// with the number of pods which run each of them
+func GetComponentVersions(component string, pods []corev1.Pod) []csiv1.ComponentVersion {
+	var componentVersions []csiv1.ComponentVersion
+	indexes := map[csiv1.ComponentVersion]int{}
+	for _, pod := range pods {
+		imageIDs := map[string]string{}
+		for _, status := range pod.Status.ContainerStatuses {
+			imageIDs[status.Name] = status.ImageID
+		}
+		for _, container := range pod.Spec.Containers {
+			key := csiv1.ComponentVersion{
+				Component: component,
+				Container: container.Name,
+				Image:     container.Image,
+				ImageID:   imageIDs[container.Name],
+				Version:   GetImageVersion(container.Image),
+			}
+			if index, found := indexes[key]; found {
+				componentVersions[index].Pods++
+				continue
+			}
+			key.Pods = 1
+			componentVersions = append(componentVersions, key)
+			key.Pods = 0
+			indexes[key] = len(componentVersions) - 1
+		}
+	}
+
+	sort.SliceStable(componentVersions, func(i, j int) bool {
+		if componentVersions[i].Container != componentVersions[j].Container {
+			return componentVersions[i].Container < componentVersions[j].Container
+		}
+		return componentVersions[i].Image < componentVersions[j].Image
+	})
+	return componentVersions
+}
+
+// GetContainerVersions returns the sorted versions a container runs in the component versions
+func GetContainerVersions(componentVersions []csiv1.ComponentVersion, container string) []string {
+	var versions []string
+	for _, componentVersion := range componentVersions {
+		if componentVersion.Container == container && !util.Contains(versions, componentVersion.Version) {
+			versions = append(versions, componentVersion.Version)
+		}
+	}
+	sort.Strings(versions)
+	return versions
+}
+
+// ValidateTrustedCA checks that the trusted CA bundle is either a ConfigMap or the injected cluster bundle
+func ValidateTrustedCA(trustedCA *csiv1.TrustedCA) error {
+	if trustedCA != nil && trustedCA.ConfigMap != "" && trustedCA.InjectClusterBundle {
+		return fmt.Errorf("trustedCA.configMap and trustedCA.injectClusterBundle cannot be both set")
+	}
+	return nil
+}
+
+// GetTrustedCAConfigMapName returns the name of the ConfigMap of the trusted CA bundle, empty if there is none.
+// injectedConfigMapName is the name of the ConfigMap generated for the injected cluster bundle.
+func GetTrustedCAConfigMapName(trustedCA *csiv1.TrustedCA, injectedConfigMapName string) string {
+	if trustedCA == nil {
+		return ""
+	}
+	if trustedCA.InjectClusterBundle {
+		return injectedConfigMapName
+	}
+	return trustedCA.ConfigMap
+}
+
+// GenerateTrustedCABundleConfigMap returns a ConfigMap which OpenShift injects the trusted CA bundle of the cluster into
+func GenerateTrustedCABundleConfigMap(name, namespace string, configMapLabels labels.Set) *corev1.ConfigMap {
+	return &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: namespace,
+			Labels:    labels.Merge(configMapLabels, labels.Set{config.InjectTrustedCABundleLabel: "true"}),
+		},
+	}
+}
+
+// GetTrustedCABundleChecksum returns the checksum of the CA bundle of a trusted CA ConfigMap,
+// empty if the ConfigMap is nil, the pods roll when it changes
+func GetTrustedCABundleChecksum(configMap *corev1.ConfigMap) string {
+	if configMap == nil {
+		return ""
+	}
+	return fmt.Sprintf("%x", sha256.Sum256([]byte(configMap.Data[config.TrustedCABundleKey])))
+}
@@ -0,0 +1,149 @@
+/**
+ * Copyright 2025 IBM Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package common
+
+import (
+	"fmt"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	csiv1 "github.com/IBM/ibm-block-csi-operator/api/v1"
+	"github.com/IBM/ibm-block-csi-operator/pkg/config"
+)
+
+// imagePullSecretTypes are the types of the Secrets which are copied for imagePullSecretSources
+var imagePullSecretTypes = sets.New(corev1.SecretTypeDockerConfigJson, corev1.SecretTypeDockercfg)
+
+// GetImagePullSecretCopyName returns the name of the copy of the pull secret of a source, <owner>-<namespace>.<name>.
+// A namespace cannot contain a dot, so the copies of different sources never share a name
+func GetImagePullSecretCopyName(source csiv1.ImagePullSecretSource, ownerName string) string {
+	return fmt.Sprintf("%s-%s.%s", ownerName, source.Namespace, source.Name)
+}
+
+// GetImagePullSecrets returns the pull secrets of the namespace and the copies of the pull secrets of the sources,
+// without duplicates
+func GetImagePullSecrets(imagePullSecrets []string, sources []csiv1.ImagePullSecretSource, ownerName string) []string {
+	names := sets.New[string]()
+	var secrets []string
+	for _, name := range imagePullSecrets {
+		if !names.Has(name) {
+			names.Insert(name)
+			secrets = append(secrets, name)
+		}
+	}
+	for _, source := range sources {
+		if name := GetImagePullSecretCopyName(source, ownerName); !names.Has(name) {
+			names.Insert(name)
+			secrets = append(secrets, name)
+		}
+	}
+	return secrets
+}
+
+// ValidateImagePullSecretSources checks the sources are named and in another namespace than the custom resource
+func ValidateImagePullSecretSources(sources []csiv1.ImagePullSecretSource, namespace string) error {
+	for _, source := range sources {
+		if source.Name == "" || source.Namespace == "" {
+			return fmt.Errorf("imagePullSecretSources %s/%s must set name and namespace", source.Namespace, source.Name)
+		}
+		if source.Namespace == namespace {
+			return fmt.Errorf("imagePullSecretSources %s/%s is in the namespace of the custom resource, "+
+				"add it to imagePullSecrets", source.Namespace, source.Name)
+		}
+	}
+	return nil
+}
+
+// ValidateImagePullSecretSource checks that the Secret of a source is a pull secret which opts in to be copied
+func ValidateImagePullSecretSource(secret *corev1.Secret) error {
+	if !imagePullSecretTypes.Has(secret.Type) {
+		return fmt.Errorf("the type of the pull secret %s/%s is %q, not %s or %s", secret.Namespace, secret.Name,
+			secret.Type, corev1.SecretTypeDockerConfigJson, corev1.SecretTypeDockercfg)
+	}
+	if secret.Labels[config.ImagePullSecretShareableLabel] != "true" {
+		return fmt.Errorf("the pull secret %s/%s does not have the %s=true label", secret.Namespace, secret.Name,
+			config.ImagePullSecretShareableLabel)
+	}
+	return nil
+}
+
+// GenerateImagePullSecretCopy returns the copy of the pull secret of a source
+func GenerateImagePullSecretCopy(source *corev1.Secret, name, namespace string, secretLabels labels.Set) *corev1.Secret {
+	copyLabels := labels.Merge(secretLabels, labels.Set{config.ImagePullSecretSourceLabel: source.Namespace})
+	return &corev1.Secret{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: "v1",
+			Kind:       "Secret",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: namespace,
+			Labels:    copyLabels,
+		},
+		Type: source.Type,
+		Data: source.Data,
+	}
+}
+
+// SetServiceAccountImagePullSecrets attaches the pull secrets to a ServiceAccount and detaches the ones the operator
+// attached before and which are not listed anymore, it keeps the pull secrets attached by others,
+// e.g. the dockercfg Secrets of OpenShift. It returns true if the ServiceAccount changed
+func SetServiceAccountImagePullSecrets(serviceAccount *corev1.ServiceAccount, imagePullSecrets []string) bool {
+	attachedValue := serviceAccount.Annotations[config.ImagePullSecretsAnnotation]
+	attached := sets.New[string]()
+	if attachedValue != "" {
+		attached.Insert(strings.Split(attachedValue, ",")...)
+	}
+	desired := sets.New(imagePullSecrets...)
+
+	changed := false
+	var references []corev1.LocalObjectReference
+	found := sets.New[string]()
+	for _, reference := range serviceAccount.ImagePullSecrets {
+		if attached.Has(reference.Name) && !desired.Has(reference.Name) {
+			changed = true
+			continue
+		}
+		found.Insert(reference.Name)
+		references = append(references, reference)
+	}
+	for _, name := range imagePullSecrets {
+		if !found.Has(name) {
+			changed = true
+			references = append(references, corev1.LocalObjectReference{Name: name})
+		}
+	}
+	serviceAccount.ImagePullSecrets = references
+
+	desiredValue := strings.Join(imagePullSecrets, ",")
+	if desiredValue == attachedValue {
+		return changed
+	}
+	if desiredValue == "" {
+		delete(serviceAccount.Annotations, config.ImagePullSecretsAnnotation)
+		return true
+	}
+	if serviceAccount.Annotations == nil {
+		serviceAccount.Annotations = map[string]string{}
+	}
+	serviceAccount.Annotations[config.ImagePullSecretsAnnotation] = desiredValue
+	return true
+}
@@ -0,0 +1,78 @@
+/**
+ * Copyright 2025 IBM Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package common_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	csiv1 "github.com/IBM/ibm-block-csi-operator/api/v1"
+	. "github.com/IBM/ibm-block-csi-operator/controllers/internal/common"
+	"github.com/IBM/ibm-block-csi-operator/pkg/config"
+)
+
+var _ = Describe("ImagePullSecrets", func() {
+	It("should name the copies of sources with the same name in different namespaces apart", func() {
+		first := csiv1.ImagePullSecretSource{Name: "registry", Namespace: "team-a"}
+		second := csiv1.ImagePullSecretSource{Name: "registry", Namespace: "team-b"}
+		Expect(GetImagePullSecretCopyName(first, "ibm-block-csi")).To(Equal("ibm-block-csi-team-a.registry"))
+		Expect(GetImagePullSecrets(nil, []csiv1.ImagePullSecretSource{first, second}, "ibm-block-csi")).To(
+			Equal([]string{"ibm-block-csi-team-a.registry", "ibm-block-csi-team-b.registry"}))
+	})
+
+	It("should copy only the pull secrets which opt in to be copied", func() {
+		secret := &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "registry",
+				Namespace: "registry-secrets",
+				Labels:    map[string]string{config.ImagePullSecretShareableLabel: "true"},
+			},
+			Type: corev1.SecretTypeDockerConfigJson,
+		}
+		Expect(ValidateImagePullSecretSource(secret)).To(Succeed())
+
+		secret.Type = corev1.SecretTypeDockercfg
+		Expect(ValidateImagePullSecretSource(secret)).To(Succeed())
+
+		secret.Type = corev1.SecretTypeOpaque
+		Expect(ValidateImagePullSecretSource(secret)).To(MatchError(ContainSubstring("Opaque")))
+
+		secret.Type = corev1.SecretTypeDockerConfigJson
+		secret.Labels = nil
+		Expect(ValidateImagePullSecretSource(secret)).To(
+			MatchError(ContainSubstring(config.ImagePullSecretShareableLabel)))
+	})
+
+	It("should keep the pull secrets it did not attach to a ServiceAccount", func() {
+		serviceAccount := &corev1.ServiceAccount{
+			ImagePullSecrets: []corev1.LocalObjectReference{{Name: "builder-dockercfg"}},
+		}
+		Expect(SetServiceAccountImagePullSecrets(serviceAccount, []string{"local", "removed"})).To(BeTrue())
+		Expect(SetServiceAccountImagePullSecrets(serviceAccount, []string{"local", "removed"})).To(BeFalse())
+
+		Expect(SetServiceAccountImagePullSecrets(serviceAccount, []string{"local"})).To(BeTrue())
+		Expect(serviceAccount.ImagePullSecrets).To(Equal([]corev1.LocalObjectReference{
+			{Name: "builder-dockercfg"}, {Name: "local"}}))
+		Expect(serviceAccount.Annotations).To(HaveKeyWithValue(config.ImagePullSecretsAnnotation, "local"))
+
+		Expect(SetServiceAccountImagePullSecrets(serviceAccount, nil)).To(BeTrue())
+		Expect(serviceAccount.ImagePullSecrets).To(Equal([]corev1.LocalObjectReference{{Name: "builder-dockercfg"}}))
+		Expect(serviceAccount.Annotations).NotTo(HaveKey(config.ImagePullSecretsAnnotation))
+	})
+})
@@ -0,0 +1,192 @@
+/**
+ * Copyright 2025 IBM Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package common
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	csiv1 "github.com/IBM/ibm-block-csi-operator/api/v1"
+	"github.com/IBM/ibm-block-csi-operator/pkg/config"
+)
+
+// ValidateIOGroupPlacement checks that the placement has the fields of its strategy and valid IO groups
+func ValidateIOGroupPlacement(placement *csiv1.IOGroupPlacement) error {
+	if placement == nil {
+		return nil
+	}
+	switch placement.Strategy {
+	case csiv1.IOGroupPlacementFixed, csiv1.IOGroupPlacementRoundRobin:
+		if len(placement.IOGroups) == 0 {
+			return fmt.Errorf("ioGroupPlacement strategy %s requires ioGroups", placement.Strategy)
+		}
+	case csiv1.IOGroupPlacementLabelMapping:
+		if placement.Label == "" {
+			return fmt.Errorf("ioGroupPlacement strategy %s requires a label", placement.Strategy)
+		}
+		fallthrough
+	case csiv1.IOGroupPlacementZoneAligned:
+		if len(placement.Mapping) == 0 {
+			return fmt.Errorf("ioGroupPlacement strategy %s requires a mapping", placement.Strategy)
+		}
+	default:
+		return fmt.Errorf("ioGroupPlacement strategy %q is unknown", placement.Strategy)
+	}
+	if err := validateIOGroups(placement.IOGroups); err != nil {
+		return err
+	}
+	for value, ioGroups := range placement.Mapping {
+		if len(ioGroups) == 0 {
+			return fmt.Errorf("ioGroupPlacement mapping %q has no IO groups", value)
+		}
+		if err := validateIOGroups(ioGroups); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func validateIOGroups(ioGroups []int) error {
+	for _, ioGroup := range ioGroups {
+		if ioGroup < 0 || ioGroup > maxIOGroup {
+			return fmt.Errorf("ioGroupPlacement %d is not an IO group between 0 and %d", ioGroup, maxIOGroup)
+		}
+	}
+	return nil
+}
+
+// GetPlacementIOGroups returns the IO groups the placement places the host of a node in, nil if it places
+// none. RoundRobin picks the IO group with the fewest nodes in nodesPerIOGroup, the first one of ioGroups
+// among IO groups with the same number of nodes. The placement must be valid
+func GetPlacementIOGroups(placement *csiv1.IOGroupPlacement, node *corev1.Node, nodesPerIOGroup map[int]int32) []int {
+	if placement == nil {
+		return nil
+	}
+	var ioGroups []int
+	switch placement.Strategy {
+	case csiv1.IOGroupPlacementFixed:
+		ioGroups = placement.IOGroups
+	case csiv1.IOGroupPlacementRoundRobin:
+		next := placement.IOGroups[0]
+		for _, ioGroup := range placement.IOGroups[1:] {
+			if nodesPerIOGroup[ioGroup] < nodesPerIOGroup[next] {
+				next = ioGroup
+			}
+		}
+		ioGroups = []int{next}
+	case csiv1.IOGroupPlacementZoneAligned:
+		ioGroups = placement.Mapping[node.Labels[corev1.LabelTopologyZone]]
+	case csiv1.IOGroupPlacementLabelMapping:
+		if value, found := node.Labels[placement.Label]; found {
+			ioGroups = placement.Mapping[value]
+		}
+	}
+	if len(ioGroups) == 0 {
+		return nil
+	}
+	return append([]int{}, ioGroups...)
+}
+
+// GetNodeIOGroups returns the IO groups of the IO group labels of a node, sorted
+func GetNodeIOGroups(node *corev1.Node) []int {
+	var ioGroups []int
+	for key, value := range node.Labels {
+		if !strings.HasPrefix(key, config.HostDefinerIOGroupLabelPrefix) || value != "true" {
+			continue
+		}
+		if ioGroup, err := strconv.Atoi(strings.TrimPrefix(key, config.HostDefinerIOGroupLabelPrefix)); err == nil {
+			ioGroups = append(ioGroups, ioGroup)
+		}
+	}
+	sort.Ints(ioGroups)
+	return ioGroups
+}
+
+// SetNodeIOGroups labels a node with the IO groups, and removes the IO group labels the operator set before
+// and which are not listed anymore, it keeps the IO group labels set by others. The NodeConnectivityPolicy the IO
+// groups come from is recorded, empty if they come from the placement. It returns true if the node changed
+func SetNodeIOGroups(node *corev1.Node, ioGroups []int, policy string) bool {
+	original := node.DeepCopy()
+	desired := sets.New[string]()
+	var values []string
+	for _, ioGroup := range ioGroups {
+		desired.Insert(config.HostDefinerIOGroupLabelPrefix + strconv.Itoa(ioGroup))
+		values = append(values, strconv.Itoa(ioGroup))
+	}
+
+	if attachedValue := node.Annotations[config.IOGroupsAnnotation]; attachedValue != "" {
+		for _, value := range strings.Split(attachedValue, ",") {
+			if label := config.HostDefinerIOGroupLabelPrefix + value; !desired.Has(label) {
+				delete(node.Labels, label)
+			}
+		}
+	}
+	for _, label := range sets.List(desired) {
+		if node.Labels == nil {
+			node.Labels = map[string]string{}
+		}
+		node.Labels[label] = "true"
+	}
+
+	setNodeAnnotation(node, config.IOGroupsAnnotation, strings.Join(values, ","))
+	if len(ioGroups) == 0 {
+		policy = ""
+	}
+	setNodeAnnotation(node, config.IOGroupsPolicyAnnotation, policy)
+	return !equality.Semantic.DeepEqual(original, node)
+}
+
+// IsNodePlacedByPolicy returns true if the operator labelled a node with the IO groups of a NodeConnectivityPolicy
+func IsNodePlacedByPolicy(node *corev1.Node) bool {
+	return node.Annotations[config.IOGroupsPolicyAnnotation] != ""
+}
+
+// setNodeAnnotation annotates a node with the value, it removes the annotation if the value is empty
+func setNodeAnnotation(node *corev1.Node, key, value string) {
+	if value == "" {
+		delete(node.Annotations, key)
+		return
+	}
+	if node.Annotations == nil {
+		node.Annotations = map[string]string{}
+	}
+	node.Annotations[key] = value
+}
+
+// GetIOGroupDistribution returns the number of nodes in each IO group by their IO group labels, sorted by IO group
+func GetIOGroupDistribution(nodes []corev1.Node) []csiv1.IOGroupNodes {
+	nodesPerIOGroup := map[int]int32{}
+	for i := range nodes {
+		for _, ioGroup := range GetNodeIOGroups(&nodes[i]) {
+			nodesPerIOGroup[ioGroup]++
+		}
+	}
+	var distribution []csiv1.IOGroupNodes
+	for ioGroup, count := range nodesPerIOGroup {
+		distribution = append(distribution, csiv1.IOGroupNodes{IOGroup: ioGroup, Nodes: count})
+	}
+	sort.Slice(distribution, func(i, j int) bool {
+		return distribution[i].IOGroup < distribution[j].IOGroup
+	})
+	return distribution
+}
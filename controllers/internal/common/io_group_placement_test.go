@@ -0,0 +1,112 @@
+/**
+ * Copyright 2025 IBM Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package common_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	csiv1 "github.com/IBM/ibm-block-csi-operator/api/v1"
+	. "github.com/IBM/ibm-block-csi-operator/controllers/internal/common"
+	"github.com/IBM/ibm-block-csi-operator/pkg/config"
+)
+
+var _ = Describe("IOGroupPlacement", func() {
+	node := &corev1.Node{ObjectMeta: metav1.ObjectMeta{
+		Name:   "worker-1",
+		Labels: map[string]string{corev1.LabelTopologyZone: "zone-a", "rack": "r2"},
+	}}
+	newNode := func(name string, ioGroupLabels ...string) corev1.Node {
+		node := corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: name, Labels: map[string]string{}}}
+		for _, ioGroup := range ioGroupLabels {
+			node.Labels[config.HostDefinerIOGroupLabelPrefix+ioGroup] = "true"
+		}
+		return node
+	}
+
+	It("should validate the fields of the strategy", func() {
+		Expect(ValidateIOGroupPlacement(nil)).To(Succeed())
+		Expect(ValidateIOGroupPlacement(&csiv1.IOGroupPlacement{
+			Strategy: csiv1.IOGroupPlacementRoundRobin, IOGroups: []int{0, 1}})).To(Succeed())
+		Expect(ValidateIOGroupPlacement(&csiv1.IOGroupPlacement{
+			Strategy: csiv1.IOGroupPlacementFixed})).NotTo(Succeed())
+		Expect(ValidateIOGroupPlacement(&csiv1.IOGroupPlacement{
+			Strategy: csiv1.IOGroupPlacementFixed, IOGroups: []int{4}})).NotTo(Succeed())
+		Expect(ValidateIOGroupPlacement(&csiv1.IOGroupPlacement{
+			Strategy: csiv1.IOGroupPlacementLabelMapping,
+			Mapping:  map[string][]int{"r1": {0}}})).NotTo(Succeed())
+		Expect(ValidateIOGroupPlacement(&csiv1.IOGroupPlacement{
+			Strategy: csiv1.IOGroupPlacementZoneAligned,
+			Mapping:  map[string][]int{"zone-a": {}}})).NotTo(Succeed())
+	})
+
+	It("should place the host with each strategy", func() {
+		Expect(GetPlacementIOGroups(&csiv1.IOGroupPlacement{
+			Strategy: csiv1.IOGroupPlacementFixed, IOGroups: []int{0, 1}}, node, nil)).To(Equal([]int{0, 1}))
+		Expect(GetPlacementIOGroups(&csiv1.IOGroupPlacement{
+			Strategy: csiv1.IOGroupPlacementRoundRobin, IOGroups: []int{0, 1, 2}}, node,
+			map[int]int32{0: 2, 1: 1, 2: 1})).To(Equal([]int{1}))
+		Expect(GetPlacementIOGroups(&csiv1.IOGroupPlacement{
+			Strategy: csiv1.IOGroupPlacementZoneAligned,
+			Mapping:  map[string][]int{"zone-a": {2}, "zone-b": {3}}}, node, nil)).To(Equal([]int{2}))
+		Expect(GetPlacementIOGroups(&csiv1.IOGroupPlacement{
+			Strategy: csiv1.IOGroupPlacementLabelMapping, Label: "rack",
+			Mapping: map[string][]int{"r1": {0}}}, node, nil)).To(BeNil())
+	})
+
+	It("should count the nodes per IO group by their labels", func() {
+		nodes := []corev1.Node{
+			newNode("worker-1", "1", "0"),
+			newNode("worker-2", "1"),
+			newNode("worker-3", "3"),
+			newNode("worker-4"),
+			newNode("worker-5", "x"),
+		}
+		Expect(GetNodeIOGroups(&nodes[0])).To(Equal([]int{0, 1}))
+		Expect(GetIOGroupDistribution(nodes)).To(Equal([]csiv1.IOGroupNodes{
+			{IOGroup: 0, Nodes: 1}, {IOGroup: 1, Nodes: 2}, {IOGroup: 3, Nodes: 1},
+		}))
+	})
+
+	It("should keep the IO group labels it did not set on a node", func() {
+		labelledNode := newNode("worker-1", "3")
+		Expect(SetNodeIOGroups(&labelledNode, []int{0, 1}, "")).To(BeTrue())
+		Expect(SetNodeIOGroups(&labelledNode, []int{0, 1}, "")).To(BeFalse())
+		Expect(labelledNode.Annotations).To(HaveKeyWithValue(config.IOGroupsAnnotation, "0,1"))
+
+		Expect(SetNodeIOGroups(&labelledNode, []int{2}, "")).To(BeTrue())
+		Expect(GetNodeIOGroups(&labelledNode)).To(Equal([]int{2, 3}))
+		Expect(labelledNode.Annotations).To(HaveKeyWithValue(config.IOGroupsAnnotation, "2"))
+
+		Expect(SetNodeIOGroups(&labelledNode, nil, "")).To(BeTrue())
+		Expect(GetNodeIOGroups(&labelledNode)).To(Equal([]int{3}))
+		Expect(labelledNode.Annotations).To(BeEmpty())
+	})
+
+	It("should record the policy the IO groups of a node come from", func() {
+		labelledNode := newNode("worker-1")
+		Expect(SetNodeIOGroups(&labelledNode, []int{1}, "bare-metal")).To(BeTrue())
+		Expect(IsNodePlacedByPolicy(&labelledNode)).To(BeTrue())
+		Expect(labelledNode.Annotations).To(HaveKeyWithValue(config.IOGroupsPolicyAnnotation, "bare-metal"))
+
+		Expect(SetNodeIOGroups(&labelledNode, []int{1}, "")).To(BeTrue())
+		Expect(IsNodePlacedByPolicy(&labelledNode)).To(BeFalse())
+		Expect(GetNodeIOGroups(&labelledNode)).To(Equal([]int{1}))
+	})
+})
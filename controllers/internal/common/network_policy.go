@@ -0,0 +1,294 @@
+/**
+ * Copyright 2025 IBM Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package common
+
+import (
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	discoveryv1 "k8s.io/api/discovery/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	storagev1 "k8s.io/api/storage/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	csiv1 "github.com/IBM/ibm-block-csi-operator/api/v1"
+	"github.com/IBM/ibm-block-csi-operator/pkg/config"
+)
+
+// storageClassSecretParameters are the prefixes of the StorageClass parameters which reference the array Secrets
+var storageClassSecretParameters = []string{
+	"csi.storage.k8s.io/provisioner-secret",
+	"csi.storage.k8s.io/controller-publish-secret",
+	"csi.storage.k8s.io/controller-expand-secret",
+	"csi.storage.k8s.io/node-stage-secret",
+}
+
+// dnsPorts are the ports of the cluster DNS, 5353 is the port of the DNS pods of OpenShift
+var dnsPorts = []int32{53, 5353}
+
+// clusterDNSPeers are the DNS pods of Kubernetes and of OpenShift
+var clusterDNSPeers = []networkingv1.NetworkPolicyPeer{
+	{
+		NamespaceSelector: metav1.SetAsLabelSelector(labels.Set{corev1.LabelMetadataName: "kube-system"}),
+		PodSelector:       metav1.SetAsLabelSelector(labels.Set{"k8s-app": "kube-dns"}),
+	},
+	{
+		NamespaceSelector: metav1.SetAsLabelSelector(labels.Set{corev1.LabelMetadataName: "openshift-dns"}),
+		PodSelector:       metav1.SetAsLabelSelector(labels.Set{"dns.operator.openshift.io/daemonset-dns": "default"}),
+	},
+}
+
+// apiServerPorts are the ports of the Kubernetes API when its endpoints are not known,
+// 6443 is the port of the API server endpoints
+var apiServerPorts = []int32{443, 6443}
+
+// proxyDefaultPorts are the ports of a proxy URL without a port
+var proxyDefaultPorts = map[string]int32{"http": 80, "https": 443}
+
+// NetworkPolicyDestination is a destination of the egress of a NetworkPolicy,
+// any address when CIDRs is empty and any port when Ports is empty
+type NetworkPolicyDestination struct {
+	CIDRs []string
+	Ports []int32
+}
+
+// IsNetworkPolicyEnabled returns true if a NetworkPolicy is generated
+func IsNetworkPolicyEnabled(networkPolicy *csiv1.NetworkPolicy) bool {
+	return networkPolicy != nil && networkPolicy.Enabled
+}
+
+// ValidateNetworkPolicy checks the array CIDRs of a NetworkPolicy
+func ValidateNetworkPolicy(networkPolicy *csiv1.NetworkPolicy) error {
+	if networkPolicy == nil {
+		return nil
+	}
+	for _, cidr := range networkPolicy.ArrayCIDRs {
+		if _, _, err := net.ParseCIDR(cidr); err != nil {
+			return fmt.Errorf("networkPolicy.arrayCIDRs %q is not a CIDR: %v", cidr, err)
+		}
+	}
+	return nil
+}
+
+// GetStorageClassSecrets returns the array Secrets a StorageClass of the driver references,
+// the Secrets with templated names are skipped
+func GetStorageClassSecrets(storageClass *storagev1.StorageClass) []types.NamespacedName {
+	if storageClass.Provisioner != config.DriverName {
+		return nil
+	}
+	secrets := sets.New[types.NamespacedName]()
+	for _, parameter := range storageClassSecretParameters {
+		secret := types.NamespacedName{
+			Name:      storageClass.Parameters[parameter+"-name"],
+			Namespace: storageClass.Parameters[parameter+"-namespace"],
+		}
+		if secret.Name == "" || strings.Contains(secret.String(), "${") {
+			continue
+		}
+		secrets.Insert(secret)
+	}
+	list := secrets.UnsortedList()
+	sort.Slice(list, func(i, j int) bool { return list[i].String() < list[j].String() })
+	return list
+}
+
+// GetArrayManagementAddresses returns the management addresses of the arrays of an array Secret
+func GetArrayManagementAddresses(secret *corev1.Secret) []string {
+	var addresses []string
+	addresses = append(addresses, splitAddresses(string(secret.Data[config.ArraySecretManagementAddressKey]))...)
+	if arraysConfig, found := secret.Data[config.ArraySecretConfigKey]; found {
+		arrays := map[string]map[string]interface{}{}
+		if err := json.Unmarshal(arraysConfig, &arrays); err == nil {
+			for _, array := range arrays {
+				if address, ok := array[config.ArraySecretManagementAddressKey].(string); ok {
+					addresses = append(addresses, splitAddresses(address)...)
+				}
+			}
+		}
+	}
+	return addresses
+}
+
+func splitAddresses(addresses string) []string {
+	var split []string
+	for _, address := range strings.Split(addresses, ",") {
+		if address = strings.TrimSpace(address); address != "" {
+			split = append(split, address)
+		}
+	}
+	return split
+}
+
+// GetAddressCIDRs returns the single address CIDRs of the IP addresses,
+// and the addresses which are not IP addresses, e.g. host names
+func GetAddressCIDRs(addresses []string) ([]string, []string) {
+	cidrs := sets.NewString()
+	others := sets.NewString()
+	for _, address := range addresses {
+		ip := net.ParseIP(address)
+		switch {
+		case ip == nil:
+			others.Insert(address)
+		case ip.To4() != nil:
+			cidrs.Insert(ip.String() + "/32")
+		default:
+			cidrs.Insert(ip.String() + "/128")
+		}
+	}
+	return cidrs.List(), others.List()
+}
+
+// GetAPIServerDestination returns the egress destination of the endpoints of the Kubernetes API,
+// from the EndpointSlices of the kubernetes Service, the API ports on any address when there are none
+func GetAPIServerDestination(endpointSlices []discoveryv1.EndpointSlice) NetworkPolicyDestination {
+	var addresses []string
+	ports := sets.New[int32]()
+	for _, endpointSlice := range endpointSlices {
+		for _, endpoint := range endpointSlice.Endpoints {
+			addresses = append(addresses, endpoint.Addresses...)
+		}
+		for _, port := range endpointSlice.Ports {
+			if port.Port != nil {
+				ports.Insert(*port.Port)
+			}
+		}
+	}
+	cidrs, _ := GetAddressCIDRs(addresses)
+	if len(cidrs) == 0 || ports.Len() == 0 {
+		return NetworkPolicyDestination{Ports: apiServerPorts}
+	}
+	return NetworkPolicyDestination{CIDRs: cidrs, Ports: sets.List(ports)}
+}
+
+// GetProxyDestinations returns the egress destinations of the proxy URLs, a proxy which is not
+// an IP address is allowed on its port to any address, nil if there is no proxy
+func GetProxyDestinations(proxy *csiv1.ProxyConfig) []NetworkPolicyDestination {
+	if proxy == nil {
+		return nil
+	}
+	var destinations []NetworkPolicyDestination
+	hosts := sets.New[string]()
+	for _, proxyURL := range []string{proxy.HTTPProxy, proxy.HTTPSProxy} {
+		parsed, err := url.Parse(proxyURL)
+		if proxyURL == "" || err != nil || parsed.Hostname() == "" || hosts.Has(parsed.Host) {
+			continue
+		}
+		port := proxyDefaultPorts[parsed.Scheme]
+		if parsed.Port() != "" {
+			parsedPort, err := strconv.ParseInt(parsed.Port(), 10, 32)
+			if err != nil {
+				continue
+			}
+			port = int32(parsedPort)
+		}
+		if port == 0 {
+			continue
+		}
+		hosts.Insert(parsed.Host)
+		destination := NetworkPolicyDestination{Ports: []int32{port}}
+		if cidrs, _ := GetAddressCIDRs([]string{parsed.Hostname()}); len(cidrs) > 0 {
+			destination.CIDRs = cidrs
+		}
+		destinations = append(destinations, destination)
+	}
+	return destinations
+}
+
+// GetNetworkPolicyDestinations returns the egress destinations of a NetworkPolicy besides the cluster DNS:
+// the Kubernetes API, the proxy and the array CIDRs
+func GetNetworkPolicyDestinations(apiServer NetworkPolicyDestination, proxy *csiv1.ProxyConfig,
+	arrayCIDRs []string) []NetworkPolicyDestination {
+	destinations := append([]NetworkPolicyDestination{apiServer}, GetProxyDestinations(proxy)...)
+	if len(arrayCIDRs) > 0 {
+		destinations = append(destinations, NetworkPolicyDestination{CIDRs: arrayCIDRs})
+	}
+	return destinations
+}
+
+// GenerateNetworkPolicy returns a NetworkPolicy of the pods which allows the ingress to the ingress ports,
+// and the egress to the DNS pods of the cluster and to the destinations
+func GenerateNetworkPolicy(name, namespace string, objectLabels, podSelector labels.Set,
+	ingressPorts []int32, destinations []NetworkPolicyDestination) *networkingv1.NetworkPolicy {
+	networkPolicy := &networkingv1.NetworkPolicy{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: networkingv1.SchemeGroupVersion.String(),
+			Kind:       "NetworkPolicy",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: namespace,
+			Labels:    objectLabels,
+		},
+		Spec: networkingv1.NetworkPolicySpec{
+			PodSelector: *metav1.SetAsLabelSelector(podSelector),
+			PolicyTypes: []networkingv1.PolicyType{networkingv1.PolicyTypeIngress, networkingv1.PolicyTypeEgress},
+			Egress: []networkingv1.NetworkPolicyEgressRule{
+				{To: clusterDNSPeers, Ports: getNetworkPolicyPorts(dnsPorts, corev1.ProtocolUDP, corev1.ProtocolTCP)},
+			},
+		},
+	}
+	if len(ingressPorts) > 0 {
+		networkPolicy.Spec.Ingress = append(networkPolicy.Spec.Ingress, networkingv1.NetworkPolicyIngressRule{
+			Ports: getNetworkPolicyPorts(ingressPorts, corev1.ProtocolTCP),
+		})
+	}
+	for _, destination := range destinations {
+		if len(destination.CIDRs) == 0 && len(destination.Ports) == 0 {
+			continue
+		}
+		var peers []networkingv1.NetworkPolicyPeer
+		for _, cidr := range destination.CIDRs {
+			peers = append(peers, networkingv1.NetworkPolicyPeer{IPBlock: &networkingv1.IPBlock{CIDR: cidr}})
+		}
+		networkPolicy.Spec.Egress = append(networkPolicy.Spec.Egress, networkingv1.NetworkPolicyEgressRule{
+			To:    peers,
+			Ports: getNetworkPolicyPorts(destination.Ports, corev1.ProtocolTCP),
+		})
+	}
+	return networkPolicy
+}
+
+func getNetworkPolicyPorts(ports []int32, protocols ...corev1.Protocol) []networkingv1.NetworkPolicyPort {
+	var policyPorts []networkingv1.NetworkPolicyPort
+	for _, port := range ports {
+		for _, protocol := range protocols {
+			portNumber := intstr.FromInt32(port)
+			protocol := protocol
+			policyPorts = append(policyPorts, networkingv1.NetworkPolicyPort{Protocol: &protocol, Port: &portNumber})
+		}
+	}
+	return policyPorts
+}
+
+// MergeArrayCIDRs returns the sorted array CIDRs of the spec and of the array Secrets
+func MergeArrayCIDRs(networkPolicy *csiv1.NetworkPolicy, secretCIDRs []string) []string {
+	cidrs := sets.NewString(secretCIDRs...)
+	if networkPolicy != nil {
+		cidrs.Insert(networkPolicy.ArrayCIDRs...)
+	}
+	return cidrs.List()
+}
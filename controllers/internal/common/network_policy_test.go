@@ -0,0 +1,98 @@
+/**
+ * Copyright 2025 IBM Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package common_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	discoveryv1 "k8s.io/api/discovery/v1"
+	storagev1 "k8s.io/api/storage/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+
+	csiv1 "github.com/IBM/ibm-block-csi-operator/api/v1"
+	. "github.com/IBM/ibm-block-csi-operator/controllers/internal/common"
+	"github.com/IBM/ibm-block-csi-operator/pkg/config"
+)
+
+var _ = Describe("NetworkPolicy", func() {
+	It("should get the Secrets of the storage classes of the driver", func() {
+		storageClass := &storagev1.StorageClass{
+			Provisioner: config.DriverName,
+			Parameters: map[string]string{
+				"csi.storage.k8s.io/provisioner-secret-name":      "array-secret",
+				"csi.storage.k8s.io/provisioner-secret-namespace": "storage",
+				"csi.storage.k8s.io/node-stage-secret-name":       "${pvc.name}",
+				"csi.storage.k8s.io/node-stage-secret-namespace":  "storage",
+			},
+		}
+		Expect(GetStorageClassSecrets(storageClass)).To(ConsistOf(
+			types.NamespacedName{Name: "array-secret", Namespace: "storage"}))
+
+		storageClass.Provisioner = "other.csi.example.com"
+		Expect(GetStorageClassSecrets(storageClass)).To(BeEmpty())
+	})
+
+	It("should get the CIDRs of the management addresses of an array Secret", func() {
+		secret := &corev1.Secret{Data: map[string][]byte{
+			config.ArraySecretManagementAddressKey: []byte("10.0.1.5, array.example.com,fd00::1"),
+		}}
+		cidrs, others := GetAddressCIDRs(GetArrayManagementAddresses(secret))
+		Expect(cidrs).To(ConsistOf("10.0.1.5/32", "fd00::1/128"))
+		Expect(others).To(ConsistOf("array.example.com"))
+	})
+
+	It("should allow the Kubernetes API on its endpoints only", func() {
+		Expect(GetAPIServerDestination(nil)).To(Equal(NetworkPolicyDestination{Ports: []int32{443, 6443}}))
+
+		endpointSlices := []discoveryv1.EndpointSlice{{
+			Endpoints: []discoveryv1.Endpoint{{Addresses: []string{"10.0.3.1", "10.0.3.2"}}},
+			Ports:     []discoveryv1.EndpointPort{{Port: ptr.To(int32(6443))}},
+		}}
+		Expect(GetAPIServerDestination(endpointSlices)).To(Equal(NetworkPolicyDestination{
+			CIDRs: []string{"10.0.3.1/32", "10.0.3.2/32"},
+			Ports: []int32{6443},
+		}))
+	})
+
+	It("should allow the proxy on its address and port", func() {
+		Expect(GetProxyDestinations(nil)).To(BeEmpty())
+		Expect(GetProxyDestinations(&csiv1.ProxyConfig{
+			HTTPProxy:  "http://10.0.2.1:3128",
+			HTTPSProxy: "https://proxy.example.com",
+		})).To(Equal([]NetworkPolicyDestination{
+			{CIDRs: []string{"10.0.2.1/32"}, Ports: []int32{3128}},
+			{Ports: []int32{443}},
+		}))
+	})
+
+	It("should allow the egress to the DNS pods of the cluster and to the destinations only", func() {
+		destinations := GetNetworkPolicyDestinations(NetworkPolicyDestination{CIDRs: []string{"10.0.3.1/32"}, Ports: []int32{6443}},
+			nil, []string{"10.0.0.0/24"})
+		networkPolicy := GenerateNetworkPolicy("policy", "default", nil, labels.Set{"app": "csi"}, nil, destinations)
+
+		Expect(networkPolicy.Spec.Egress).To(HaveLen(3))
+		Expect(networkPolicy.Spec.Egress[0].To).To(HaveLen(2))
+		Expect(networkPolicy.Spec.Egress[0].To[0].PodSelector.MatchLabels).To(HaveKeyWithValue("k8s-app", "kube-dns"))
+		Expect(networkPolicy.Spec.Egress[1].To[0].IPBlock.CIDR).To(Equal("10.0.3.1/32"))
+		Expect(networkPolicy.Spec.Egress[1].Ports[0].Port.IntVal).To(Equal(int32(6443)))
+		Expect(networkPolicy.Spec.Egress[2].To[0].IPBlock.CIDR).To(Equal("10.0.0.0/24"))
+		Expect(networkPolicy.Spec.Egress[2].Ports).To(BeEmpty())
+	})
+})
@@ -0,0 +1,202 @@
+/**
+ * Copyright 2025 IBM Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package common
+
+import (
+	"fmt"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/validation"
+
+	csiv1 "github.com/IBM/ibm-block-csi-operator/api/v1"
+	"github.com/IBM/ibm-block-csi-operator/pkg/config"
+)
+
+// NodeNameTemplateVariable is replaced by the name of the node in the name on storage template of a policy
+const NodeNameTemplateVariable = "${node.name}"
+
+// maxIOGroup is the highest IO group of a storage system
+const maxIOGroup = 3
+
+// the fields of a policy the operator sets for the host definer
+const (
+	connectivityTypeField  = "connectivityType"
+	portSetField           = "portSet"
+	ioGroupsField          = "ioGroups"
+	nodeNameOnStorageField = "nodeNameOnStorage"
+)
+
+// ValidateNodeConnectivityPolicy checks the node selector, the IO groups and the name on storage template of a policy
+func ValidateNodeConnectivityPolicy(policy *csiv1.NodeConnectivityPolicy) error {
+	if _, err := metav1.LabelSelectorAsSelector(&policy.Spec.NodeSelector); err != nil {
+		return fmt.Errorf("nodeSelector is invalid: %v", err)
+	}
+	for _, ioGroup := range policy.Spec.IOGroups {
+		if ioGroup < 0 || ioGroup > maxIOGroup {
+			return fmt.Errorf("ioGroups %d is not an IO group between 0 and %d", ioGroup, maxIOGroup)
+		}
+	}
+	template := policy.Spec.NodeNameOnStorageTemplate
+	if strings.Contains(strings.ReplaceAll(template, NodeNameTemplateVariable, ""), "${") {
+		return fmt.Errorf("nodeNameOnStorageTemplate %q has an unknown variable, only %s is supported",
+			template, NodeNameTemplateVariable)
+	}
+	if errs := validation.IsValidLabelValue(policy.Spec.PortSet); len(errs) > 0 {
+		return fmt.Errorf("portSet %q is not a label value: %s", policy.Spec.PortSet, strings.Join(errs, ", "))
+	}
+	return nil
+}
+
+// ResolveNodeConnectivityPolicy returns the policy with the highest priority which selects the node,
+// the policy with the first name among policies with the same priority, nil if no policy selects the node.
+// The policies must be valid
+func ResolveNodeConnectivityPolicy(policies []csiv1.NodeConnectivityPolicy, node *corev1.Node) *csiv1.NodeConnectivityPolicy {
+	var resolved *csiv1.NodeConnectivityPolicy
+	for i := range policies {
+		policy := &policies[i]
+		selector, err := metav1.LabelSelectorAsSelector(&policy.Spec.NodeSelector)
+		if err != nil || !selector.Matches(labels.Set(node.Labels)) {
+			continue
+		}
+		if resolved == nil || policy.Spec.Priority > resolved.Spec.Priority ||
+			(policy.Spec.Priority == resolved.Spec.Priority && policy.Name < resolved.Name) {
+			resolved = policy
+		}
+	}
+	return resolved
+}
+
+// SetHostDefinitionConnectivityPolicy sets the values of the policy which applies to the node of a HostDefinition
+// in the fields the host definer defines the host with, and records the policy and the fields in the annotations.
+// The fields the policy does not set are left to the host definer, and the fields the operator set before are
+// cleared when the policy, which may be nil, does not set them anymore. It returns true if the HostDefinition changed
+func SetHostDefinitionConnectivityPolicy(hostDefinition *csiv1.HostDefinition, policy *csiv1.NodeConnectivityPolicy) bool {
+	original := hostDefinition.DeepCopy()
+	definition := &hostDefinition.Spec.HostDefinition
+	for _, field := range getAppliedPolicyFields(hostDefinition.Annotations) {
+		switch field {
+		case connectivityTypeField:
+			definition.ConnectivityType = ""
+		case portSetField:
+			definition.PortSet = ""
+		case ioGroupsField:
+			definition.IOGroups = nil
+		case nodeNameOnStorageField:
+			definition.NodeNameOnStorage = ""
+		}
+	}
+
+	var fields []string
+	if policy != nil {
+		if policy.Spec.ConnectivityType != "" {
+			definition.ConnectivityType = string(policy.Spec.ConnectivityType)
+			fields = append(fields, connectivityTypeField)
+		}
+		if policy.Spec.PortSet != "" {
+			definition.PortSet = policy.Spec.PortSet
+			fields = append(fields, portSetField)
+		}
+		if len(policy.Spec.IOGroups) > 0 {
+			definition.IOGroups = append([]int{}, policy.Spec.IOGroups...)
+			fields = append(fields, ioGroupsField)
+		}
+		if nodeNameOnStorage := getNodeNameOnStorage(policy, definition.NodeName); nodeNameOnStorage != "" {
+			definition.NodeNameOnStorage = nodeNameOnStorage
+			fields = append(fields, nodeNameOnStorageField)
+		}
+	}
+	setAppliedPolicyAnnotations(&hostDefinition.ObjectMeta, policy, fields)
+	return !equality.Semantic.DeepEqual(original, hostDefinition)
+}
+
+// SetNodeConnectivityPolicy labels a node with the connectivity type and the port set of the policy which applies
+// to it, and annotates it with the name of its hosts on the storage, so the host definer defines the hosts of the
+// node with them before any HostDefinition exists. The IO groups of the policy are set by the IO group placement.
+// The policy and the values set are recorded in the annotations, and the values set before are removed when the
+// policy, which may be nil, does not set them anymore. It returns true if the node changed
+func SetNodeConnectivityPolicy(node *corev1.Node, policy *csiv1.NodeConnectivityPolicy) bool {
+	original := node.DeepCopy()
+	for _, field := range getAppliedPolicyFields(node.Annotations) {
+		switch field {
+		case connectivityTypeField:
+			delete(node.Labels, config.HostDefinerConnectivityTypeLabel)
+		case portSetField:
+			delete(node.Labels, config.HostDefinerPortSetLabel)
+		case nodeNameOnStorageField:
+			delete(node.Annotations, config.HostDefinerNodeNameOnStorageAnnotation)
+		}
+	}
+
+	var fields []string
+	if policy != nil {
+		if policy.Spec.ConnectivityType != "" {
+			node.Labels = labels.Merge(node.Labels,
+				labels.Set{config.HostDefinerConnectivityTypeLabel: string(policy.Spec.ConnectivityType)})
+			fields = append(fields, connectivityTypeField)
+		}
+		if policy.Spec.PortSet != "" {
+			node.Labels = labels.Merge(node.Labels, labels.Set{config.HostDefinerPortSetLabel: policy.Spec.PortSet})
+			fields = append(fields, portSetField)
+		}
+		if nodeNameOnStorage := getNodeNameOnStorage(policy, node.Name); nodeNameOnStorage != "" {
+			if node.Annotations == nil {
+				node.Annotations = map[string]string{}
+			}
+			node.Annotations[config.HostDefinerNodeNameOnStorageAnnotation] = nodeNameOnStorage
+			fields = append(fields, nodeNameOnStorageField)
+		}
+	}
+	setAppliedPolicyAnnotations(&node.ObjectMeta, policy, fields)
+	return !equality.Semantic.DeepEqual(original, node)
+}
+
+// getNodeNameOnStorage returns the name of the hosts of a node on the storage from the template of the policy,
+// empty if the policy has no template
+func getNodeNameOnStorage(policy *csiv1.NodeConnectivityPolicy, nodeName string) string {
+	return strings.ReplaceAll(policy.Spec.NodeNameOnStorageTemplate, NodeNameTemplateVariable, nodeName)
+}
+
+// getAppliedPolicyFields returns the fields the operator set from a policy, by the annotations
+func getAppliedPolicyFields(annotations map[string]string) []string {
+	value := annotations[config.NodeConnectivityPolicyFieldsAnnotation]
+	if value == "" {
+		return nil
+	}
+	return strings.Split(value, ",")
+}
+
+// setAppliedPolicyAnnotations records the policy, which may be nil, and the fields the operator set from it
+func setAppliedPolicyAnnotations(objectMeta *metav1.ObjectMeta, policy *csiv1.NodeConnectivityPolicy, fields []string) {
+	if policy == nil {
+		delete(objectMeta.Annotations, config.NodeConnectivityPolicyAnnotation)
+		delete(objectMeta.Annotations, config.NodeConnectivityPolicyFieldsAnnotation)
+		return
+	}
+	if objectMeta.Annotations == nil {
+		objectMeta.Annotations = map[string]string{}
+	}
+	objectMeta.Annotations[config.NodeConnectivityPolicyAnnotation] = policy.Name
+	if len(fields) == 0 {
+		delete(objectMeta.Annotations, config.NodeConnectivityPolicyFieldsAnnotation)
+		return
+	}
+	objectMeta.Annotations[config.NodeConnectivityPolicyFieldsAnnotation] = strings.Join(fields, ",")
+}
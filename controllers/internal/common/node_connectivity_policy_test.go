@@ -0,0 +1,158 @@
+/**
+ * Copyright 2025 IBM Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package common_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	csiv1 "github.com/IBM/ibm-block-csi-operator/api/v1"
+	. "github.com/IBM/ibm-block-csi-operator/controllers/internal/common"
+	"github.com/IBM/ibm-block-csi-operator/pkg/config"
+)
+
+var _ = Describe("NodeConnectivityPolicy", func() {
+	newPolicy := func(name string, priority int32, matchLabels map[string]string) csiv1.NodeConnectivityPolicy {
+		return csiv1.NodeConnectivityPolicy{
+			ObjectMeta: metav1.ObjectMeta{Name: name},
+			Spec: csiv1.NodeConnectivityPolicySpec{
+				NodeSelector: metav1.LabelSelector{MatchLabels: matchLabels},
+				Priority:     priority,
+			},
+		}
+	}
+	node := &corev1.Node{ObjectMeta: metav1.ObjectMeta{
+		Name:   "worker-1",
+		Labels: map[string]string{"pool": "bare-metal"},
+	}}
+
+	It("should resolve the selecting policy with the highest priority", func() {
+		policies := []csiv1.NodeConnectivityPolicy{
+			newPolicy("all", 0, nil),
+			newPolicy("vm", 20, map[string]string{"pool": "vm"}),
+			newPolicy("bare-metal-b", 10, map[string]string{"pool": "bare-metal"}),
+			newPolicy("bare-metal-a", 10, map[string]string{"pool": "bare-metal"}),
+		}
+		Expect(ResolveNodeConnectivityPolicy(policies, node).Name).To(Equal("bare-metal-a"))
+		Expect(ResolveNodeConnectivityPolicy(policies[1:2], node)).To(BeNil())
+	})
+
+	It("should set the values of the policy in the HostDefinitions of the node", func() {
+		policy := newPolicy("bare-metal", 0, nil)
+		policy.Spec.ConnectivityType = csiv1.ConnectivityTypeFC
+		policy.Spec.PortSet = "portset1"
+		policy.Spec.IOGroups = []int{0, 1}
+		policy.Spec.NodeNameOnStorageTemplate = "ocp-" + NodeNameTemplateVariable
+		hostDefinition := &csiv1.HostDefinition{Spec: csiv1.HostDefinitionSpec{HostDefinition: csiv1.Definition{
+			NodeName:         node.Name,
+			ConnectivityType: string(csiv1.ConnectivityTypeISCSI),
+		}}}
+		Expect(SetHostDefinitionConnectivityPolicy(hostDefinition, &policy)).To(BeTrue())
+		Expect(SetHostDefinitionConnectivityPolicy(hostDefinition, &policy)).To(BeFalse())
+		Expect(hostDefinition.Annotations).To(Equal(map[string]string{
+			config.NodeConnectivityPolicyAnnotation:       "bare-metal",
+			config.NodeConnectivityPolicyFieldsAnnotation: "connectivityType,portSet,ioGroups,nodeNameOnStorage",
+		}))
+		Expect(hostDefinition.Spec.HostDefinition).To(Equal(csiv1.Definition{
+			NodeName:          node.Name,
+			ConnectivityType:  string(csiv1.ConnectivityTypeFC),
+			PortSet:           "portset1",
+			NodeNameOnStorage: "ocp-worker-1",
+			IOGroups:          []int{0, 1},
+		}))
+	})
+
+	It("should clear the values it set when the policy is removed or stops setting them", func() {
+		policy := newPolicy("bare-metal", 0, nil)
+		policy.Spec.ConnectivityType = csiv1.ConnectivityTypeFC
+		policy.Spec.PortSet = "portset1"
+		policy.Spec.IOGroups = []int{0, 1}
+		hostDefinition := &csiv1.HostDefinition{Spec: csiv1.HostDefinitionSpec{HostDefinition: csiv1.Definition{
+			NodeName:          node.Name,
+			NodeNameOnStorage: "worker-1",
+		}}}
+		Expect(SetHostDefinitionConnectivityPolicy(hostDefinition, &policy)).To(BeTrue())
+
+		policy.Spec.PortSet = ""
+		policy.Spec.IOGroups = nil
+		Expect(SetHostDefinitionConnectivityPolicy(hostDefinition, &policy)).To(BeTrue())
+		Expect(hostDefinition.Annotations).To(HaveKeyWithValue(config.NodeConnectivityPolicyFieldsAnnotation, "connectivityType"))
+		Expect(hostDefinition.Spec.HostDefinition.PortSet).To(BeEmpty())
+		Expect(hostDefinition.Spec.HostDefinition.IOGroups).To(BeEmpty())
+
+		Expect(SetHostDefinitionConnectivityPolicy(hostDefinition, nil)).To(BeTrue())
+		Expect(SetHostDefinitionConnectivityPolicy(hostDefinition, nil)).To(BeFalse())
+		Expect(hostDefinition.Annotations).To(BeEmpty())
+		Expect(hostDefinition.Spec.HostDefinition).To(Equal(csiv1.Definition{
+			NodeName:          node.Name,
+			NodeNameOnStorage: "worker-1",
+		}))
+	})
+
+	It("should label the node with the values of the policy until it is removed", func() {
+		policy := newPolicy("bare-metal", 0, nil)
+		policy.Spec.ConnectivityType = csiv1.ConnectivityTypeNVMeOverTCP
+		policy.Spec.PortSet = "portset1"
+		policy.Spec.NodeNameOnStorageTemplate = NodeNameTemplateVariable + "-tcp"
+		labelledNode := node.DeepCopy()
+		Expect(SetNodeConnectivityPolicy(labelledNode, &policy)).To(BeTrue())
+		Expect(SetNodeConnectivityPolicy(labelledNode, &policy)).To(BeFalse())
+		Expect(labelledNode.Labels).To(Equal(map[string]string{
+			"pool":                                  "bare-metal",
+			config.HostDefinerConnectivityTypeLabel: string(csiv1.ConnectivityTypeNVMeOverTCP),
+			config.HostDefinerPortSetLabel:          "portset1",
+		}))
+		Expect(labelledNode.Annotations).To(HaveKeyWithValue(config.HostDefinerNodeNameOnStorageAnnotation, "worker-1-tcp"))
+
+		Expect(SetNodeConnectivityPolicy(labelledNode, nil)).To(BeTrue())
+		Expect(labelledNode.Labels).To(Equal(node.Labels))
+		Expect(labelledNode.Annotations).To(BeEmpty())
+	})
+
+	It("should leave the values the policy does not set to the host definer", func() {
+		hostDefinition := &csiv1.HostDefinition{Spec: csiv1.HostDefinitionSpec{HostDefinition: csiv1.Definition{
+			NodeName:          node.Name,
+			ConnectivityType:  string(csiv1.ConnectivityTypeISCSI),
+			NodeNameOnStorage: "worker-1",
+			IOGroups:          []int{2},
+		}}}
+		policy := newPolicy("bare-metal", 0, nil)
+		Expect(SetHostDefinitionConnectivityPolicy(hostDefinition, &policy)).To(BeTrue())
+		Expect(hostDefinition.Spec.HostDefinition).To(Equal(csiv1.Definition{
+			NodeName:          node.Name,
+			ConnectivityType:  string(csiv1.ConnectivityTypeISCSI),
+			NodeNameOnStorage: "worker-1",
+			IOGroups:          []int{2},
+		}))
+	})
+
+	It("should refuse an unknown template variable, IO group or port set", func() {
+		policy := newPolicy("bare-metal", 0, nil)
+		policy.Spec.NodeNameOnStorageTemplate = "${node.uid}"
+		Expect(ValidateNodeConnectivityPolicy(&policy)).To(MatchError(ContainSubstring("unknown variable")))
+
+		policy.Spec.NodeNameOnStorageTemplate = ""
+		policy.Spec.IOGroups = []int{4}
+		Expect(ValidateNodeConnectivityPolicy(&policy)).To(MatchError(ContainSubstring("ioGroups 4")))
+
+		policy.Spec.IOGroups = nil
+		policy.Spec.PortSet = "port set"
+		Expect(ValidateNodeConnectivityPolicy(&policy)).To(MatchError(ContainSubstring("portSet")))
+	})
+})
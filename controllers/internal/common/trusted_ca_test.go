@@ -0,0 +1,46 @@
+/**
+ * Copyright 2025 IBM Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package common_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	. "github.com/IBM/ibm-block-csi-operator/controllers/internal/common"
+	"github.com/IBM/ibm-block-csi-operator/pkg/config"
+)
+
+var _ = Describe("TrustedCA", func() {
+	It("should change the checksum only with the CA bundle of the ConfigMap", func() {
+		Expect(GetTrustedCABundleChecksum(nil)).To(BeEmpty())
+
+		configMap := &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Name: "my-ca"},
+			Data:       map[string]string{config.TrustedCABundleKey: "bundle"},
+		}
+		checksum := GetTrustedCABundleChecksum(configMap)
+		Expect(checksum).NotTo(BeEmpty())
+
+		configMap.Data["other"] = "value"
+		Expect(GetTrustedCABundleChecksum(configMap)).To(Equal(checksum))
+
+		configMap.Data[config.TrustedCABundleKey] = "other bundle"
+		Expect(GetTrustedCABundleChecksum(configMap)).NotTo(Equal(checksum))
+	})
+})
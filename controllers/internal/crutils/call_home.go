@@ -0,0 +1,67 @@
+/**
+ * Copyright 2025 IBM Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package crutils
+
+import (
+	"fmt"
+	"net/url"
+
+	csiv1 "github.com/IBM/ibm-block-csi-operator/api/v1"
+)
+
+// IsCallHomeEnabled returns true if the controller plugin calls home,
+// callHome takes precedence over the deprecated enableCallHome
+func (c *IBMBlockCSI) IsCallHomeEnabled() bool {
+	if c.Spec.CallHome != nil {
+		return c.Spec.CallHome.Enabled
+	}
+	return c.Spec.EnableCallHome != "false"
+}
+
+// GetCallHomeProxy returns the proxy of the controller plugin, the cluster-wide proxy when callHome sets none,
+// nil if the controller plugin is not proxied
+func (c *IBMBlockCSI) GetCallHomeProxy() *csiv1.ProxyConfig {
+	if c.Spec.CallHome != nil && c.Spec.CallHome.Proxy != nil {
+		return c.Spec.CallHome.Proxy
+	}
+	return c.ClusterProxy
+}
+
+// ValidateCallHome checks the proxy URLs of the call home
+func (c *IBMBlockCSI) ValidateCallHome() error {
+	callHome := c.Spec.CallHome
+	if callHome == nil || callHome.Proxy == nil {
+		return nil
+	}
+	proxyURLs := []struct {
+		name string
+		url  string
+	}{
+		{name: "httpProxy", url: callHome.Proxy.HTTPProxy},
+		{name: "httpsProxy", url: callHome.Proxy.HTTPSProxy},
+	}
+	for _, proxyURL := range proxyURLs {
+		if proxyURL.url == "" {
+			continue
+		}
+		parsed, err := url.Parse(proxyURL.url)
+		if err != nil || (parsed.Scheme != "http" && parsed.Scheme != "https") || parsed.Host == "" {
+			return fmt.Errorf("callHome.proxy.%s %q is not an http or https URL", proxyURL.name, proxyURL.url)
+		}
+	}
+	return nil
+}
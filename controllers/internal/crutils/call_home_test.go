@@ -0,0 +1,57 @@
+/**
+ * Copyright 2025 IBM Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package crutils_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	csiv1 "github.com/IBM/ibm-block-csi-operator/api/v1"
+	. "github.com/IBM/ibm-block-csi-operator/controllers/internal/crutils"
+)
+
+var _ = Describe("CallHome", func() {
+	var ibcWrapper *IBMBlockCSI
+	clusterProxy := &csiv1.ProxyConfig{HTTPSProxy: "http://cluster-proxy:3128"}
+
+	BeforeEach(func() {
+		ibcWrapper = New(&csiv1.IBMBlockCSI{}, "1.28")
+		ibcWrapper.Spec.EnableCallHome = "true"
+	})
+
+	It("should take callHome over enableCallHome", func() {
+		Expect(ibcWrapper.IsCallHomeEnabled()).To(BeTrue())
+		ibcWrapper.Spec.CallHome = &csiv1.CallHome{Enabled: false}
+		Expect(ibcWrapper.IsCallHomeEnabled()).To(BeFalse())
+	})
+
+	It("should use the cluster-wide proxy unless callHome sets one", func() {
+		ibcWrapper.ClusterProxy = clusterProxy
+		Expect(ibcWrapper.GetCallHomeProxy()).To(Equal(clusterProxy))
+		proxy := &csiv1.ProxyConfig{HTTPProxy: "http://call-home-proxy:8080"}
+		ibcWrapper.Spec.CallHome = &csiv1.CallHome{Enabled: true, Proxy: proxy}
+		Expect(ibcWrapper.GetCallHomeProxy()).To(Equal(proxy))
+	})
+
+	It("should refuse a proxy URL which is not http or https", func() {
+		ibcWrapper.Spec.CallHome = &csiv1.CallHome{Enabled: true, Proxy: &csiv1.ProxyConfig{HTTPSProxy: "http://proxy:3128"}}
+		Expect(ibcWrapper.Validate()).To(Succeed())
+
+		ibcWrapper.Spec.CallHome.Proxy.HTTPSProxy = "proxy:3128"
+		Expect(ibcWrapper.Validate()).To(MatchError(ContainSubstring("callHome.proxy.httpsProxy")))
+	})
+})
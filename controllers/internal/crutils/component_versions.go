@@ -0,0 +1,83 @@
+/**
+ * Copyright 2025 IBM Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package crutils
+
+import (
+	"fmt"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	csiv1 "github.com/IBM/ibm-block-csi-operator/api/v1"
+	"github.com/IBM/ibm-block-csi-operator/controllers/internal/common"
+	"github.com/IBM/ibm-block-csi-operator/controllers/util"
+	"github.com/IBM/ibm-block-csi-operator/pkg/config"
+)
+
+// SetComponentVersions sets the versions the controller and node pods run, and the VersionSkew condition
+func (c *IBMBlockCSI) SetComponentVersions(controllerPods, nodePods []corev1.Pod) {
+	controllerVersions := common.GetComponentVersions(config.CSIController.String(), controllerPods)
+	nodeVersions := common.GetComponentVersions(config.CSINode.String(), nodePods)
+	c.Status.ComponentVersions = append(controllerVersions, nodeVersions...)
+
+	var skew []string
+	var versions []string
+	addVersions := func(source string, sourceVersions ...string) {
+		if len(sourceVersions) == 0 {
+			return
+		}
+		skew = append(skew, fmt.Sprintf("%s %s", source, strings.Join(sourceVersions, ", ")))
+		for _, version := range sourceVersions {
+			if !util.Contains(versions, version) {
+				versions = append(versions, version)
+			}
+		}
+	}
+	var runningVersions []string
+	if c.GetControllerManagementState() != csiv1.ManagementStateRemoved {
+		controllerPluginVersions := common.GetContainerVersions(controllerVersions, config.CSIControllerContainerName)
+		addVersions("controller runs", controllerPluginVersions...)
+		addVersions("desired controller is", common.GetImageVersion(c.GetCSIControllerImage()))
+		runningVersions = append(runningVersions, controllerPluginVersions...)
+	}
+	if c.GetNodeManagementState() != csiv1.ManagementStateRemoved {
+		nodePluginVersions := common.GetContainerVersions(nodeVersions, config.CSINodeContainerName)
+		addVersions("node runs", nodePluginVersions...)
+		addVersions("desired node is", common.GetImageVersion(c.GetCSINodeImage()))
+		runningVersions = append(runningVersions, nodePluginVersions...)
+	}
+
+	c.Status.Version = ""
+	if len(versions) == 1 && len(runningVersions) > 0 {
+		c.Status.Version = versions[0]
+	}
+
+	condition := metav1.Condition{
+		Type:               csiv1.ConditionVersionSkew,
+		ObservedGeneration: c.Generation,
+		Status:             metav1.ConditionFalse,
+		Reason:             "VersionsMatch",
+		Message:            strings.Join(skew, "; "),
+	}
+	if len(versions) > 1 {
+		condition.Status = metav1.ConditionTrue
+		condition.Reason = "VersionsDiffer"
+	}
+	meta.SetStatusCondition(&c.Status.Conditions, condition)
+}
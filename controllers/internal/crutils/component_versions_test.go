@@ -0,0 +1,110 @@
+/**
+ * Copyright 2025 IBM Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package crutils_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	csiv1 "github.com/IBM/ibm-block-csi-operator/api/v1"
+	. "github.com/IBM/ibm-block-csi-operator/controllers/internal/crutils"
+	"github.com/IBM/ibm-block-csi-operator/pkg/config"
+)
+
+func getPodRunning(containerName, image, imageID string) corev1.Pod {
+	return corev1.Pod{
+		Spec: corev1.PodSpec{
+			Containers: []corev1.Container{{Name: containerName, Image: image}},
+		},
+		Status: corev1.PodStatus{
+			ContainerStatuses: []corev1.ContainerStatus{{Name: containerName, ImageID: imageID}},
+		},
+	}
+}
+
+var _ = Describe("ComponentVersions", func() {
+	var ibcWrapper *IBMBlockCSI
+
+	BeforeEach(func() {
+		ibcWrapper = New(&csiv1.IBMBlockCSI{
+			Spec: csiv1.IBMBlockCSISpec{
+				Controller: csiv1.IBMBlockCSIControllerSpec{Repository: "registry/controller", Tag: "1.12.3"},
+				Node:       csiv1.IBMBlockCSINodeSpec{Repository: "registry/node", Tag: "1.12.3"},
+			},
+		}, "1.13")
+	})
+
+	getCondition := func() *metav1.Condition {
+		return meta.FindStatusCondition(ibcWrapper.Status.Conditions, csiv1.ConditionVersionSkew)
+	}
+
+	It("should report the versions the pods run", func() {
+		ibcWrapper.SetComponentVersions(
+			[]corev1.Pod{getPodRunning(config.CSIControllerContainerName, "registry/controller:1.12.3", "id-c")},
+			[]corev1.Pod{
+				getPodRunning(config.CSINodeContainerName, "registry/node:1.12.3", "id-n"),
+				getPodRunning(config.CSINodeContainerName, "registry/node:1.12.3", "id-n"),
+			})
+
+		Expect(ibcWrapper.Status.Version).To(Equal("1.12.3"))
+		Expect(ibcWrapper.Status.ComponentVersions).To(Equal([]csiv1.ComponentVersion{
+			{Component: "csi-controller", Container: config.CSIControllerContainerName,
+				Image: "registry/controller:1.12.3", ImageID: "id-c", Version: "1.12.3", Pods: 1},
+			{Component: "csi-node", Container: config.CSINodeContainerName,
+				Image: "registry/node:1.12.3", ImageID: "id-n", Version: "1.12.3", Pods: 2},
+		}))
+		Expect(getCondition().Status).To(Equal(metav1.ConditionFalse))
+	})
+
+	It("should report a skew while the node runs an old version", func() {
+		ibcWrapper.SetComponentVersions(
+			[]corev1.Pod{getPodRunning(config.CSIControllerContainerName, "registry/controller:1.12.3", "id-c")},
+			[]corev1.Pod{
+				getPodRunning(config.CSINodeContainerName, "registry/node:1.12.2", "id-old"),
+				getPodRunning(config.CSINodeContainerName, "registry/node:1.12.3", "id-n"),
+			})
+
+		Expect(ibcWrapper.Status.Version).To(BeEmpty())
+		Expect(ibcWrapper.Status.ComponentVersions).To(HaveLen(3))
+		condition := getCondition()
+		Expect(condition.Status).To(Equal(metav1.ConditionTrue))
+		Expect(condition.Message).To(ContainSubstring("node runs 1.12.2, 1.12.3"))
+	})
+
+	It("should report a skew when a version other than the desired one is pinned", func() {
+		ibcWrapper.Spec.Node.Tag = "1.12.4"
+		ibcWrapper.SetComponentVersions(
+			[]corev1.Pod{getPodRunning(config.CSIControllerContainerName, "registry/controller:1.12.3", "id-c")},
+			[]corev1.Pod{getPodRunning(config.CSINodeContainerName, "registry/node:1.12.3", "id-n")})
+
+		Expect(getCondition().Status).To(Equal(metav1.ConditionTrue))
+		Expect(getCondition().Message).To(ContainSubstring("desired node is 1.12.4"))
+	})
+
+	It("should ignore a removed component", func() {
+		ibcWrapper.Spec.Node.ManagementState = csiv1.ManagementStateRemoved
+		ibcWrapper.Spec.Node.Tag = "1.12.4"
+		ibcWrapper.SetComponentVersions(
+			[]corev1.Pod{getPodRunning(config.CSIControllerContainerName, "registry/controller:1.12.3", "id-c")}, nil)
+
+		Expect(ibcWrapper.Status.Version).To(Equal("1.12.3"))
+		Expect(getCondition().Status).To(Equal(metav1.ConditionFalse))
+	})
+})
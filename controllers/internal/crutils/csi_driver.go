@@ -0,0 +1,114 @@
+/**
+ * Copyright 2025 IBM Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package crutils
+
+import (
+	"fmt"
+	"reflect"
+
+	storagev1 "k8s.io/api/storage/v1"
+	utilversion "k8s.io/apimachinery/pkg/util/version"
+)
+
+// csiDriverMutableVersion is the first Kubernetes version in which podInfoOnMount and fsGroupPolicy are mutable
+var csiDriverMutableVersion = utilversion.MustParseGeneric("1.29")
+
+// ValidateCSIDriver checks the CSIDriver fields, and that the Kubernetes version of the cluster supports
+// the fields which are set. The versions are not checked when the Kubernetes version is unknown.
+func (c *IBMBlockCSI) ValidateCSIDriver() error {
+	options := c.Spec.CSIDriver
+	if options == nil {
+		return nil
+	}
+	for _, mode := range options.VolumeLifecycleModes {
+		if mode != storagev1.VolumeLifecyclePersistent && mode != storagev1.VolumeLifecycleEphemeral {
+			return fmt.Errorf("csiDriver.volumeLifecycleModes has an unknown mode %q", mode)
+		}
+	}
+
+	serverVersion, err := utilversion.ParseGeneric(c.ServerVersion)
+	if err != nil {
+		return nil
+	}
+
+	fields := []struct {
+		name       string
+		set        bool
+		minVersion string
+	}{
+		{name: "fsGroupPolicy", set: options.FSGroupPolicy != nil, minVersion: "1.20"},
+		{name: "requiresRepublish", set: options.RequiresRepublish != nil, minVersion: "1.21"},
+		{name: "tokenRequests", set: len(options.TokenRequests) > 0, minVersion: "1.21"},
+		{name: "seLinuxMount", set: options.SELinuxMount != nil, minVersion: "1.27"},
+	}
+	for _, field := range fields {
+		if field.set && serverVersion.LessThan(utilversion.MustParseGeneric(field.minVersion)) {
+			return fmt.Errorf("csiDriver.%s requires Kubernetes %s or later, the cluster runs %s",
+				field.name, field.minVersion, c.ServerVersion)
+		}
+	}
+	return nil
+}
+
+// IsCSIDriverChanged returns true if the spec of the CSIDriver differs from the desired one,
+// a field which is not set equals its Kubernetes default
+func IsCSIDriverChanged(current, desired *storagev1.CSIDriver) bool {
+	return !reflect.DeepEqual(normalizeCSIDriverSpec(current.Spec), normalizeCSIDriverSpec(desired.Spec))
+}
+
+// IsCSIDriverRecreateRequired returns true if the CSIDriver must be recreated to change it,
+// because a field which changes is immutable in the Kubernetes version of the cluster
+func (c *IBMBlockCSI) IsCSIDriverRecreateRequired(current, desired *storagev1.CSIDriver) bool {
+	currentSpec, desiredSpec := normalizeCSIDriverSpec(current.Spec), normalizeCSIDriverSpec(desired.Spec)
+	if !reflect.DeepEqual(currentSpec.AttachRequired, desiredSpec.AttachRequired) ||
+		!reflect.DeepEqual(currentSpec.VolumeLifecycleModes, desiredSpec.VolumeLifecycleModes) {
+		return true
+	}
+
+	serverVersion, err := utilversion.ParseGeneric(c.ServerVersion)
+	if err == nil && serverVersion.AtLeast(csiDriverMutableVersion) {
+		return false
+	}
+	return !reflect.DeepEqual(currentSpec.PodInfoOnMount, desiredSpec.PodInfoOnMount) ||
+		!reflect.DeepEqual(currentSpec.FSGroupPolicy, desiredSpec.FSGroupPolicy)
+}
+
+// normalizeCSIDriverSpec sets the Kubernetes defaults of the fields which are not set
+func normalizeCSIDriverSpec(spec storagev1.CSIDriverSpec) storagev1.CSIDriverSpec {
+	normalized := *spec.DeepCopy()
+	setDefaultBool := func(field **bool, value bool) {
+		if *field == nil {
+			*field = &value
+		}
+	}
+	setDefaultBool(&normalized.AttachRequired, true)
+	setDefaultBool(&normalized.PodInfoOnMount, false)
+	setDefaultBool(&normalized.StorageCapacity, false)
+	setDefaultBool(&normalized.RequiresRepublish, false)
+	setDefaultBool(&normalized.SELinuxMount, false)
+	if normalized.FSGroupPolicy == nil {
+		fsGroupPolicy := storagev1.ReadWriteOnceWithFSTypeFSGroupPolicy
+		normalized.FSGroupPolicy = &fsGroupPolicy
+	}
+	if len(normalized.VolumeLifecycleModes) == 0 {
+		normalized.VolumeLifecycleModes = []storagev1.VolumeLifecycleMode{storagev1.VolumeLifecyclePersistent}
+	}
+	if len(normalized.TokenRequests) == 0 {
+		normalized.TokenRequests = nil
+	}
+	return normalized
+}
@@ -0,0 +1,85 @@
+/**
+ * Copyright 2025 IBM Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package crutils_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	storagev1 "k8s.io/api/storage/v1"
+
+	csiv1 "github.com/IBM/ibm-block-csi-operator/api/v1"
+	. "github.com/IBM/ibm-block-csi-operator/controllers/internal/crutils"
+	"github.com/IBM/ibm-block-csi-operator/pkg/util/boolptr"
+)
+
+var _ = Describe("CSIDriver", func() {
+	var ibcWrapper *IBMBlockCSI
+	fileFSGroupPolicy := storagev1.FileFSGroupPolicy
+
+	BeforeEach(func() {
+		ibcWrapper = New(&csiv1.IBMBlockCSI{}, "1.28")
+	})
+
+	It("should generate the configured fields", func() {
+		ibcWrapper.Spec.CSIDriver = &csiv1.CSIDriverOptions{
+			FSGroupPolicy:  &fileFSGroupPolicy,
+			PodInfoOnMount: boolptr.True(),
+			TokenRequests:  []storagev1.TokenRequest{{Audience: "ibm"}},
+		}
+		spec := ibcWrapper.GenerateCSIDriver().Spec
+		Expect(*spec.FSGroupPolicy).To(Equal(fileFSGroupPolicy))
+		Expect(*spec.PodInfoOnMount).To(BeTrue())
+		Expect(*spec.AttachRequired).To(BeTrue())
+		Expect(spec.TokenRequests).To(HaveLen(1))
+	})
+
+	It("should refuse fields the Kubernetes version does not support", func() {
+		ibcWrapper.Spec.CSIDriver = &csiv1.CSIDriverOptions{SELinuxMount: boolptr.True()}
+		ibcWrapper.ServerVersion = "1.26"
+		Expect(ibcWrapper.ValidateCSIDriver()).To(MatchError(ContainSubstring("seLinuxMount requires Kubernetes 1.27")))
+		ibcWrapper.ServerVersion = "1.27"
+		Expect(ibcWrapper.ValidateCSIDriver()).To(Succeed())
+		ibcWrapper.ServerVersion = ""
+		ibcWrapper.Spec.CSIDriver.VolumeLifecycleModes = []storagev1.VolumeLifecycleMode{"Unknown"}
+		Expect(ibcWrapper.ValidateCSIDriver()).To(MatchError(ContainSubstring("unknown mode")))
+	})
+
+	It("should not see the Kubernetes defaults as a change", func() {
+		current := ibcWrapper.GenerateCSIDriver()
+		current.Spec.FSGroupPolicy = new(storagev1.FSGroupPolicy)
+		*current.Spec.FSGroupPolicy = storagev1.ReadWriteOnceWithFSTypeFSGroupPolicy
+		current.Spec.VolumeLifecycleModes = []storagev1.VolumeLifecycleMode{storagev1.VolumeLifecyclePersistent}
+		current.Spec.StorageCapacity = boolptr.False()
+		current.Spec.RequiresRepublish = boolptr.False()
+		Expect(IsCSIDriverChanged(current, ibcWrapper.GenerateCSIDriver())).To(BeFalse())
+	})
+
+	It("should recreate the CSIDriver to change its immutable fields", func() {
+		current := ibcWrapper.GenerateCSIDriver()
+		ibcWrapper.Spec.CSIDriver = &csiv1.CSIDriverOptions{FSGroupPolicy: &fileFSGroupPolicy}
+		desired := ibcWrapper.GenerateCSIDriver()
+		Expect(IsCSIDriverChanged(current, desired)).To(BeTrue())
+		Expect(ibcWrapper.IsCSIDriverRecreateRequired(current, desired)).To(BeTrue())
+
+		ibcWrapper.ServerVersion = "1.29"
+		Expect(ibcWrapper.IsCSIDriverRecreateRequired(current, desired)).To(BeFalse())
+
+		ibcWrapper.Spec.CSIDriver.VolumeLifecycleModes = []storagev1.VolumeLifecycleMode{
+			storagev1.VolumeLifecyclePersistent, storagev1.VolumeLifecycleEphemeral}
+		Expect(ibcWrapper.IsCSIDriverRecreateRequired(current, ibcWrapper.GenerateCSIDriver())).To(BeTrue())
+	})
+})
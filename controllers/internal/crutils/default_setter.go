@@ -19,6 +19,7 @@ package crutils
 import (
 	"path"
 
+	csiv1 "github.com/IBM/ibm-block-csi-operator/api/v1"
 	"github.com/IBM/ibm-block-csi-operator/pkg/config"
 	corev1 "k8s.io/api/core/v1"
 )
@@ -106,13 +107,28 @@ func (c *IBMBlockCSI) setDefaultForNilSliceFields() {
 	}
 }
 
+// setDefaultSidecars sets the default sidecars of the Kubernetes version of the cluster,
+// so that they change when the cluster is upgraded to a version of other sidecars.
+// The enabled of the sidecars is kept.
 func (c *IBMBlockCSI) setDefaultSidecars() bool {
 	var change = false
-	var defaultSidecars = config.DefaultIBMBlockCSICr.Spec.Sidecars
+	var defaultSidecars = config.GetDefaultSidecars(c.ServerVersion)
+
+	for i := range defaultSidecars {
+		for _, sidecar := range c.Spec.Sidecars {
+			if sidecar.Name == defaultSidecars[i].Name {
+				defaultSidecars[i].Enabled = sidecar.Enabled
+			}
+		}
+	}
 
 	if len(defaultSidecars) == len(c.Spec.Sidecars) {
+		defaultSidecarsByName := make(map[string]csiv1.CSISidecar)
+		for _, defaultSidecar := range defaultSidecars {
+			defaultSidecarsByName[defaultSidecar.Name] = defaultSidecar
+		}
 		for _, sidecar := range c.Spec.Sidecars {
-			if defaultSidecar, found := config.DefaultSidecarsByName[sidecar.Name]; found {
+			if defaultSidecar, found := defaultSidecarsByName[sidecar.Name]; found {
 				if sidecar != defaultSidecar {
 					change = true
 				}
@@ -0,0 +1,268 @@
+/**
+ * Copyright 2025 IBM Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package crutils
+
+import (
+	"encoding/base64"
+	"fmt"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	csiv1 "github.com/IBM/ibm-block-csi-operator/api/v1"
+	"github.com/IBM/ibm-block-csi-operator/pkg/config"
+)
+
+const (
+	defaultMachineConfigRole = "worker"
+	defaultNoPathRetry       = "fail"
+	defaultSCSITimeout       = int32(120)
+	defaultService           = "multipathd.service"
+
+	// multipathConfigFileMode is 0600 and udevRulesFileMode is 0644
+	multipathConfigFileMode = 384
+	udevRulesFileMode       = 420
+
+	multipathDefaultsSection = `defaults {
+    path_checker tur
+    path_selector "round-robin 0"
+    rr_weight uniform
+    prio const
+    rr_min_io_rq 1
+    polling_interval 30
+    path_grouping_policy multibus
+    find_multipaths yes
+    no_path_retry %s
+    user_friendly_names yes
+    failback immediate
+    checker_timeout 10
+    fast_io_fail_tmo off
+}
+
+`
+	multipathBlacklistSection = `blacklist {
+}
+`
+	scsiTimeoutUdevRule = `# Set SCSI command timeout to %[1]ds (default == 30 or 60) for IBM 2145 devices
+SUBSYSTEM=="block", ACTION=="add", ENV{ID_VENDOR}=="IBM",ENV{ID_MODEL}=="2145", RUN+="/bin/sh -c 'echo %[1]d >/sys/block/%%k/device/timeout'"
+`
+)
+
+// MachineConfigGroupVersionKind is the GroupVersionKind of the OpenShift MachineConfig
+var MachineConfigGroupVersionKind = schema.GroupVersionKind{
+	Group:   config.MachineConfigApiGroup,
+	Version: config.MachineConfigVersion,
+	Kind:    config.MachineConfigKind,
+}
+
+var (
+	defaultDevLossTmo        = int32(120)
+	flashSystemRRMinIORq     = int32(4)
+	flashSystem9840RRMinIORq = int32(1000)
+	ibm2145RRMinIORq         = int32(1)
+)
+
+// defaultMultipathDevices are the devices of deploy/99-ibm-attach.yaml
+var defaultMultipathDevices = []csiv1.MultipathDevice{
+	{
+		Vendor:             "IBM",
+		Product:            "FlashSystem",
+		PathGroupingPolicy: "multibus",
+		PathSelector:       "round-robin 0",
+		RRMinIORq:          &flashSystemRRMinIORq,
+	},
+	{
+		Vendor:             "IBM",
+		Product:            "FlashSystem-9840",
+		PathGroupingPolicy: "multibus",
+		PathSelector:       "round-robin 0",
+		FastIOFailTmo:      "off",
+		RRMinIORq:          &flashSystem9840RRMinIORq,
+	},
+	{
+		Vendor:             "IBM",
+		Product:            "2145",
+		PathGroupingPolicy: "group_by_prio",
+		PathSelector:       "service-time 0",
+		Prio:               "alua",
+		RRMinIORq:          &ibm2145RRMinIORq,
+		NoPathRetry:        "5",
+		DevLossTmo:         &defaultDevLossTmo,
+	},
+}
+
+// GetHostPrerequisites returns the host prerequisites of the spec on top of the defaults,
+// nil when the spec has none
+func (c *IBMBlockCSI) GetHostPrerequisites() *csiv1.HostPrerequisites {
+	if c.Spec.HostPrerequisites == nil {
+		return nil
+	}
+
+	hostPrerequisites := c.Spec.HostPrerequisites.DeepCopy()
+	if len(hostPrerequisites.Roles) == 0 {
+		hostPrerequisites.Roles = []string{defaultMachineConfigRole}
+	}
+	if len(hostPrerequisites.MultipathDevices) == 0 {
+		for _, device := range defaultMultipathDevices {
+			hostPrerequisites.MultipathDevices = append(hostPrerequisites.MultipathDevices, *device.DeepCopy())
+		}
+	}
+	if hostPrerequisites.NoPathRetry == "" {
+		hostPrerequisites.NoPathRetry = defaultNoPathRetry
+	}
+	if hostPrerequisites.SCSITimeout == nil {
+		scsiTimeout := defaultSCSITimeout
+		hostPrerequisites.SCSITimeout = &scsiTimeout
+	}
+	if len(hostPrerequisites.Services) == 0 {
+		hostPrerequisites.Services = []string{defaultService}
+	}
+	return hostPrerequisites
+}
+
+// GetMachineConfigName returns the name of the MachineConfig of a role
+func (c *IBMBlockCSI) GetMachineConfigName(role string) string {
+	return fmt.Sprintf("%s-%s-%s", config.MachineConfigPriority, role,
+		config.GetNameForResource(config.HostPrerequisitesMachineConfig, c.Name))
+}
+
+// GetMachineConfigSelectorLabels returns the labels of all the MachineConfigs of the IBMBlockCSI
+func (c *IBMBlockCSI) GetMachineConfigSelectorLabels() labels.Set {
+	return labels.Set{
+		"app.kubernetes.io/instance":       c.Name,
+		"app.kubernetes.io/managed-by":     config.Name,
+		config.MachineConfigNamespaceLabel: c.Namespace,
+	}
+}
+
+// GenerateMachineConfigs returns a MachineConfig per role of the host prerequisites,
+// none when the spec has no host prerequisites
+func (c *IBMBlockCSI) GenerateMachineConfigs() []*unstructured.Unstructured {
+	hostPrerequisites := c.GetHostPrerequisites()
+	if hostPrerequisites == nil {
+		return nil
+	}
+
+	ignitionConfig := map[string]interface{}{
+		"ignition": map[string]interface{}{
+			"version": config.IgnitionVersion,
+		},
+		"storage": map[string]interface{}{
+			"files": []interface{}{
+				getIgnitionFile(config.MultipathConfigFilePath, multipathConfigFileMode,
+					getMultipathConfigFile(hostPrerequisites)),
+				getIgnitionFile(config.SCSITimeoutUdevRulePath, udevRulesFileMode,
+					getSCSITimeoutUdevRules(hostPrerequisites)),
+			},
+		},
+		"systemd": map[string]interface{}{
+			"units": getIgnitionUnits(hostPrerequisites.Services),
+		},
+	}
+
+	var machineConfigs []*unstructured.Unstructured
+	for _, role := range hostPrerequisites.Roles {
+		machineConfig := &unstructured.Unstructured{}
+		machineConfig.SetGroupVersionKind(MachineConfigGroupVersionKind)
+		machineConfig.SetName(c.GetMachineConfigName(role))
+		machineConfig.SetLabels(labels.Merge(labels.Merge(c.GetLabels(), c.GetMachineConfigSelectorLabels()),
+			labels.Set{config.MachineConfigRoleLabel: role}))
+		machineConfig.Object["spec"] = map[string]interface{}{
+			"config": ignitionConfig,
+		}
+		machineConfigs = append(machineConfigs, machineConfig)
+	}
+	return machineConfigs
+}
+
+func getIgnitionFile(path string, mode int64, content string) interface{} {
+	return map[string]interface{}{
+		"path":      path,
+		"mode":      mode,
+		"overwrite": true,
+		"contents": map[string]interface{}{
+			"source": "data:text/plain;charset=utf-8;base64," + base64.StdEncoding.EncodeToString([]byte(content)),
+		},
+	}
+}
+
+func getIgnitionUnits(services []string) []interface{} {
+	var units []interface{}
+	for _, service := range services {
+		units = append(units, map[string]interface{}{
+			"name":    service,
+			"enabled": true,
+		})
+	}
+	return units
+}
+
+func getMultipathConfigFile(hostPrerequisites *csiv1.HostPrerequisites) string {
+	var builder strings.Builder
+	builder.WriteString(fmt.Sprintf(multipathDefaultsSection, hostPrerequisites.NoPathRetry))
+	builder.WriteString("devices {\n")
+	for _, device := range hostPrerequisites.MultipathDevices {
+		builder.WriteString("    device {\n")
+		writeMultipathAttribute(&builder, "vendor", fmt.Sprintf("%q", device.Vendor))
+		writeMultipathAttribute(&builder, "product", fmt.Sprintf("%q", device.Product))
+		writeMultipathAttribute(&builder, "path_checker", "tur")
+		if device.PathGroupingPolicy != "" {
+			writeMultipathAttribute(&builder, "path_grouping_policy", device.PathGroupingPolicy)
+		}
+		if device.PathSelector != "" {
+			writeMultipathAttribute(&builder, "path_selector", fmt.Sprintf("%q", device.PathSelector))
+		}
+		if device.Prio != "" {
+			writeMultipathAttribute(&builder, "prio", device.Prio)
+		}
+		if device.FastIOFailTmo != "" {
+			writeMultipathAttribute(&builder, "fast_io_fail_tmo", device.FastIOFailTmo)
+		}
+		if device.RRMinIORq != nil {
+			writeMultipathAttribute(&builder, "rr_min_io_rq", fmt.Sprint(*device.RRMinIORq))
+		}
+		noPathRetry := device.NoPathRetry
+		if noPathRetry == "" {
+			noPathRetry = hostPrerequisites.NoPathRetry
+		}
+		writeMultipathAttribute(&builder, "no_path_retry", noPathRetry)
+		devLossTmo := device.DevLossTmo
+		if devLossTmo == nil {
+			devLossTmo = hostPrerequisites.DevLossTmo
+		}
+		if devLossTmo != nil {
+			writeMultipathAttribute(&builder, "dev_loss_tmo", fmt.Sprint(*devLossTmo))
+		}
+		writeMultipathAttribute(&builder, "failback", "immediate")
+		writeMultipathAttribute(&builder, "rr_weight", "uniform")
+		builder.WriteString("    }\n")
+	}
+	builder.WriteString("}\n")
+	builder.WriteString(multipathBlacklistSection)
+	return builder.String()
+}
+
+func writeMultipathAttribute(builder *strings.Builder, name, value string) {
+	builder.WriteString(fmt.Sprintf("        %s %s\n", name, value))
+}
+
+// getSCSITimeoutUdevRules returns the udev rule of deploy/99-ibm-attach.yaml with the SCSI timeout of the spec
+func getSCSITimeoutUdevRules(hostPrerequisites *csiv1.HostPrerequisites) string {
+	return fmt.Sprintf(scsiTimeoutUdevRule, *hostPrerequisites.SCSITimeout)
+}
@@ -0,0 +1,174 @@
+/**
+ * Copyright 2025 IBM Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package crutils_test
+
+import (
+	"encoding/base64"
+	"net/url"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"sigs.k8s.io/yaml"
+
+	csiv1 "github.com/IBM/ibm-block-csi-operator/api/v1"
+	. "github.com/IBM/ibm-block-csi-operator/controllers/internal/crutils"
+	"github.com/IBM/ibm-block-csi-operator/pkg/config"
+)
+
+func getMachineConfigFile(machineConfig *unstructured.Unstructured, path string) string {
+	files, found, err := unstructured.NestedSlice(machineConfig.Object, "spec", "config", "storage", "files")
+	Expect(err).NotTo(HaveOccurred())
+	Expect(found).To(BeTrue())
+	for _, file := range files {
+		fileMap := file.(map[string]interface{})
+		if fileMap["path"] != path {
+			continue
+		}
+		source, _, err := unstructured.NestedString(fileMap, "contents", "source")
+		Expect(err).NotTo(HaveOccurred())
+		content, err := base64.StdEncoding.DecodeString(source[strings.Index(source, ",")+1:])
+		Expect(err).NotTo(HaveOccurred())
+		return string(content)
+	}
+	Fail("file " + path + " is not in the MachineConfig")
+	return ""
+}
+
+// getAttachFile returns a file of deploy/99-ibm-attach.yaml
+func getAttachFile(path string) string {
+	content, err := os.ReadFile(filepath.Join("..", "..", "..", "deploy", "99-ibm-attach.yaml"))
+	Expect(err).NotTo(HaveOccurred())
+	machineConfig := &unstructured.Unstructured{}
+	Expect(yaml.Unmarshal(content, &machineConfig.Object)).To(Succeed())
+	files, _, err := unstructured.NestedSlice(machineConfig.Object, "spec", "config", "storage", "files")
+	Expect(err).NotTo(HaveOccurred())
+	for _, file := range files {
+		fileMap := file.(map[string]interface{})
+		if fileMap["path"] != path {
+			continue
+		}
+		source, _, err := unstructured.NestedString(fileMap, "contents", "source")
+		Expect(err).NotTo(HaveOccurred())
+		decoded, err := url.PathUnescape(strings.TrimPrefix(source, "data:,"))
+		Expect(err).NotTo(HaveOccurred())
+		return decoded
+	}
+	Fail("file " + path + " is not in deploy/99-ibm-attach.yaml")
+	return ""
+}
+
+// getMultipathSections returns the sorted attributes of each section of a multipath.conf,
+// without the comments, the quotes and the indentation which multipath ignores
+func getMultipathSections(multipathConfig string) []string {
+	var sections, attributes []string
+	for _, line := range strings.Split(multipathConfig, "\n") {
+		line = strings.TrimSpace(strings.SplitN(line, "#", 2)[0])
+		line = strings.Join(strings.Fields(strings.ReplaceAll(line, "\"", "")), " ")
+		switch {
+		case line == "":
+		case strings.HasSuffix(line, "{"):
+			attributes = append(attributes, line)
+		case line == "}":
+			sort.Strings(attributes)
+			sections = append(sections, strings.Join(attributes, "\n"))
+			attributes = nil
+		default:
+			attributes = append(attributes, line)
+		}
+	}
+	return sections
+}
+
+var _ = Describe("HostPrerequisites", func() {
+	var ibc *csiv1.IBMBlockCSI
+
+	BeforeEach(func() {
+		ibc = &csiv1.IBMBlockCSI{ObjectMeta: metav1.ObjectMeta{Name: "ibm-block-csi", Namespace: "default"}}
+	})
+
+	It("should not generate a MachineConfig without host prerequisites", func() {
+		Expect(New(ibc, "1.13").GenerateMachineConfigs()).To(BeEmpty())
+	})
+
+	It("should generate the default MachineConfig of the worker role", func() {
+		ibc.Spec.HostPrerequisites = &csiv1.HostPrerequisites{}
+
+		machineConfigs := New(ibc, "1.13").GenerateMachineConfigs()
+		Expect(machineConfigs).To(HaveLen(1))
+		Expect(machineConfigs[0].GetName()).To(Equal("99-worker-ibm-block-csi-ibm-attach"))
+		Expect(machineConfigs[0].GetLabels()).To(HaveKeyWithValue(config.MachineConfigRoleLabel, "worker"))
+
+		multipathConfig := getMachineConfigFile(machineConfigs[0], config.MultipathConfigFilePath)
+		Expect(multipathConfig).To(ContainSubstring("product \"2145\""))
+		Expect(multipathConfig).To(ContainSubstring("no_path_retry fail"))
+		Expect(multipathConfig).To(ContainSubstring("dev_loss_tmo 120"))
+		Expect(getMachineConfigFile(machineConfigs[0], config.SCSITimeoutUdevRulePath)).To(ContainSubstring("echo 120 >"))
+	})
+
+	It("should reproduce deploy/99-ibm-attach.yaml by default", func() {
+		ibc.Spec.HostPrerequisites = &csiv1.HostPrerequisites{}
+
+		machineConfig := New(ibc, "1.13").GenerateMachineConfigs()[0]
+		Expect(getMultipathSections(getMachineConfigFile(machineConfig, config.MultipathConfigFilePath))).To(
+			Equal(getMultipathSections(getAttachFile(config.MultipathConfigFilePath))))
+		Expect(getMachineConfigFile(machineConfig, config.SCSITimeoutUdevRulePath)).To(
+			Equal(getAttachFile(config.SCSITimeoutUdevRulePath)))
+	})
+
+	It("should select the MachineConfigs of the IBMBlockCSI namespace", func() {
+		ibc.Spec.HostPrerequisites = &csiv1.HostPrerequisites{}
+		other := ibc.DeepCopy()
+		other.Namespace = "other"
+
+		selector := New(ibc, "1.13").GetMachineConfigSelectorLabels()
+		Expect(New(ibc, "1.13").GenerateMachineConfigs()[0].GetLabels()).To(HaveKeyWithValue(
+			config.MachineConfigNamespaceLabel, "default"))
+		Expect(selector.AsSelector().Matches(labels.Set(
+			New(other, "1.13").GenerateMachineConfigs()[0].GetLabels()))).To(BeFalse())
+	})
+
+	It("should generate the multipath devices and timeouts of the spec", func() {
+		scsiTimeout := int32(60)
+		devLossTmo := int32(30)
+		ibc.Spec.HostPrerequisites = &csiv1.HostPrerequisites{
+			Roles:       []string{"worker", "master"},
+			NoPathRetry: "queue",
+			DevLossTmo:  &devLossTmo,
+			SCSITimeout: &scsiTimeout,
+			MultipathDevices: []csiv1.MultipathDevice{
+				{Vendor: "IBM", Product: "2145", Prio: "alua"},
+			},
+		}
+
+		machineConfigs := New(ibc, "1.13").GenerateMachineConfigs()
+		Expect(machineConfigs).To(HaveLen(2))
+		Expect(machineConfigs[1].GetName()).To(Equal("99-master-ibm-block-csi-ibm-attach"))
+
+		multipathConfig := getMachineConfigFile(machineConfigs[1], config.MultipathConfigFilePath)
+		Expect(multipathConfig).NotTo(ContainSubstring("FlashSystem"))
+		Expect(multipathConfig).To(ContainSubstring("no_path_retry queue"))
+		Expect(multipathConfig).To(ContainSubstring("dev_loss_tmo 30"))
+		Expect(getMachineConfigFile(machineConfigs[1], config.SCSITimeoutUdevRulePath)).To(ContainSubstring("echo 60 >"))
+	})
+})
@@ -18,6 +18,8 @@ package crutils
 
 import (
 	"fmt"
+	"slices"
+	"time"
 
 	csiv1 "github.com/IBM/ibm-block-csi-operator/api/v1"
 	"github.com/IBM/ibm-block-csi-operator/controllers/internal/common"
@@ -30,6 +32,25 @@ import (
 type IBMBlockCSI struct {
 	*csiv1.IBMBlockCSI
 	ServerVersion string
+	// HostDefinerConnectivityType is the connectivity type of the HostDefiner in the namespace, if any
+	HostDefinerConnectivityType csiv1.ConnectivityType
+	// PolicyConnectivityTypes are the connectivity types of the valid NodeConnectivityPolicies
+	PolicyConnectivityTypes []csiv1.ConnectivityType
+	// MissingSidecarAPIs are the CRDs of the sidecar APIs the cluster does not serve, by sidecar name,
+	// nil if the APIs were not discovered
+	MissingSidecarAPIs map[string][]string
+	// ClusterProxy is the cluster-wide proxy, from the environment of the operator or the Proxy of OpenShift,
+	// nil if the cluster has none
+	ClusterProxy *csiv1.ProxyConfig
+	// TrustedCABundleChecksum is the checksum of the trusted CA bundle, the pods roll when it changes
+	TrustedCABundleChecksum string
+	// SecurityContextConstraintsAvailable is true if the cluster serves the SecurityContextConstraints of OpenShift
+	SecurityContextConstraintsAvailable bool
+	// ArraySecretCIDRs are the CIDRs of the management addresses in the Secrets of the storage classes of the driver
+	ArraySecretCIDRs []string
+
+	// APIServerDestination is the egress destination of the endpoints of the Kubernetes API
+	APIServerDestination common.NetworkPolicyDestination
 }
 
 // New returns a wrapper for csiv1.IBMBlockCSI
@@ -121,9 +142,81 @@ func (c *IBMBlockCSI) GetCSINodeImage() string {
 	return c.Spec.Node.Repository + ":" + c.Spec.Node.Tag
 }
 
+// IsReconcilePaused returns true if the CR has the pause annotation
+func (c *IBMBlockCSI) IsReconcilePaused() bool {
+	return common.IsReconcilePaused(c.Annotations)
+}
+
+// GetControllerManagementState returns the management state of the controller
+func (c *IBMBlockCSI) GetControllerManagementState() csiv1.ManagementState {
+	return common.GetManagementState(c.Spec.Controller.ManagementState, c.Spec.ManagementState)
+}
+
+// GetNodeManagementState returns the management state of the node
+func (c *IBMBlockCSI) GetNodeManagementState() csiv1.ManagementState {
+	return common.GetManagementState(c.Spec.Node.ManagementState, c.Spec.ManagementState)
+}
+
+// GetControllerOverridesHash returns the hash of the controller pod template overrides
+func (c *IBMBlockCSI) GetControllerOverridesHash() string {
+	return common.GetOverridesHash(c.Spec.Controller.Overrides)
+}
+
+// GetNodeOverridesHash returns the hash of the node pod template overrides
+func (c *IBMBlockCSI) GetNodeOverridesHash() string {
+	return common.GetOverridesHash(c.Spec.Node.Overrides)
+}
+
+// GetNodeConnectivityTypes returns the connectivity types the node is prepared for, those of the HostDefiner
+// and of the NodeConnectivityPolicies
+func (c *IBMBlockCSI) GetNodeConnectivityTypes() []csiv1.ConnectivityType {
+	if len(c.Spec.Node.ConnectivityTypes) > 0 {
+		return c.Spec.Node.ConnectivityTypes
+	}
+	connectivityTypes := []csiv1.ConnectivityType{csiv1.ConnectivityTypeFC, csiv1.ConnectivityTypeISCSI}
+	switch c.HostDefinerConnectivityType {
+	case csiv1.ConnectivityTypeNVMeOverFC, csiv1.ConnectivityTypeNVMeOverTCP,
+		csiv1.ConnectivityTypeFC, csiv1.ConnectivityTypeISCSI:
+		connectivityTypes = []csiv1.ConnectivityType{c.HostDefinerConnectivityType}
+	}
+	for _, connectivityType := range c.PolicyConnectivityTypes {
+		if !slices.Contains(connectivityTypes, connectivityType) {
+			connectivityTypes = append(connectivityTypes, connectivityType)
+		}
+	}
+	return connectivityTypes
+}
+
+// IsNodeConnectivityTypeEnabled returns true if the node is prepared for any of the connectivity types
+func (c *IBMBlockCSI) IsNodeConnectivityTypeEnabled(connectivityTypes ...csiv1.ConnectivityType) bool {
+	for _, enabled := range c.GetNodeConnectivityTypes() {
+		for _, connectivityType := range connectivityTypes {
+			if enabled == connectivityType {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// IsCapacityTrackingEnabled returns true if the csi-provisioner publishes the storage capacity
+func (c *IBMBlockCSI) IsCapacityTrackingEnabled() bool {
+	return c.Spec.CapacityTracking != nil && c.Spec.CapacityTracking.Enabled
+}
+
+// GetCapacityPollInterval returns how often the csi-provisioner gets the capacity, 0 for its default
+func (c *IBMBlockCSI) GetCapacityPollInterval() time.Duration {
+	if !c.IsCapacityTrackingEnabled() || c.Spec.CapacityTracking.PollInterval == nil {
+		return 0
+	}
+	return c.Spec.CapacityTracking.PollInterval.Duration
+}
+
 func (c *IBMBlockCSI) GetDefaultSidecarImageByName(name string) string {
-	if sidecar, found := config.DefaultSidecarsByName[name]; found {
-		return fmt.Sprintf("%s:%s", sidecar.Repository, sidecar.Tag)
+	for _, sidecar := range config.GetDefaultSidecars(c.ServerVersion) {
+		if sidecar.Name == name {
+			return fmt.Sprintf("%s:%s", sidecar.Repository, sidecar.Tag)
+		}
 	}
 	return ""
 }
@@ -0,0 +1,80 @@
+/**
+ * Copyright 2025 IBM Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package crutils_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	csiv1 "github.com/IBM/ibm-block-csi-operator/api/v1"
+	. "github.com/IBM/ibm-block-csi-operator/controllers/internal/crutils"
+	"github.com/IBM/ibm-block-csi-operator/pkg/config"
+)
+
+var _ = Describe("IBMBlockCSI", func() {
+
+	Context("test management states", func() {
+
+		It("should default to managed", func() {
+			ibcWrapper := New(&csiv1.IBMBlockCSI{}, "1.13")
+			Expect(ibcWrapper.GetControllerManagementState()).To(Equal(csiv1.ManagementStateManaged))
+			Expect(ibcWrapper.GetNodeManagementState()).To(Equal(csiv1.ManagementStateManaged))
+		})
+
+		It("should inherit the driver management state", func() {
+			ibcWrapper := New(&csiv1.IBMBlockCSI{
+				Spec: csiv1.IBMBlockCSISpec{
+					ManagementState: csiv1.ManagementStateUnmanaged,
+					Node: csiv1.IBMBlockCSINodeSpec{
+						ManagementState: csiv1.ManagementStateRemoved,
+					},
+				}}, "1.13")
+			Expect(ibcWrapper.GetControllerManagementState()).To(Equal(csiv1.ManagementStateUnmanaged))
+			Expect(ibcWrapper.GetNodeManagementState()).To(Equal(csiv1.ManagementStateRemoved))
+		})
+	})
+
+	Context("test node connectivity types", func() {
+
+		It("should prepare the node for the connectivity types of the policies", func() {
+			ibcWrapper := New(&csiv1.IBMBlockCSI{}, "1.13")
+			ibcWrapper.HostDefinerConnectivityType = csiv1.ConnectivityTypeFC
+			Expect(ibcWrapper.IsNodeConnectivityTypeEnabled(csiv1.ConnectivityTypeNVMeOverTCP)).To(BeFalse())
+
+			ibcWrapper.PolicyConnectivityTypes = []csiv1.ConnectivityType{
+				csiv1.ConnectivityTypeNVMeOverTCP, csiv1.ConnectivityTypeFC}
+			Expect(ibcWrapper.GetNodeConnectivityTypes()).To(Equal([]csiv1.ConnectivityType{
+				csiv1.ConnectivityTypeFC, csiv1.ConnectivityTypeNVMeOverTCP}))
+			Expect(ibcWrapper.IsNodeConnectivityTypeEnabled(csiv1.ConnectivityTypeNVMeOverTCP)).To(BeTrue())
+		})
+	})
+
+	Context("test IsReconcilePaused", func() {
+
+		It("should be paused only when the annotation is true", func() {
+			ibc := &csiv1.IBMBlockCSI{}
+			ibcWrapper := New(ibc, "1.13")
+			Expect(ibcWrapper.IsReconcilePaused()).To(BeFalse())
+
+			ibc.Annotations = map[string]string{config.PauseReconcileAnnotation: "false"}
+			Expect(ibcWrapper.IsReconcilePaused()).To(BeFalse())
+
+			ibc.Annotations = map[string]string{config.PauseReconcileAnnotation: "true"}
+			Expect(ibcWrapper.IsReconcilePaused()).To(BeTrue())
+		})
+	})
+})
@@ -0,0 +1,36 @@
+/**
+ * Copyright 2025 IBM Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package crutils
+
+import (
+	corev1 "k8s.io/api/core/v1"
+
+	csiv1 "github.com/IBM/ibm-block-csi-operator/api/v1"
+	"github.com/IBM/ibm-block-csi-operator/controllers/internal/common"
+)
+
+// GetImagePullSecrets returns the pull secrets attached to the ServiceAccounts of the controller and the node
+func (c *IBMBlockCSI) GetImagePullSecrets() []string {
+	return common.GetImagePullSecrets(c.Spec.ImagePullSecrets, c.Spec.ImagePullSecretSources, c.Name)
+}
+
+// GenerateImagePullSecretCopy returns the copy of the pull secret of a source in the namespace of the IBMBlockCSI
+func (c *IBMBlockCSI) GenerateImagePullSecretCopy(source csiv1.ImagePullSecretSource,
+	sourceSecret *corev1.Secret) *corev1.Secret {
+	return common.GenerateImagePullSecretCopy(sourceSecret,
+		common.GetImagePullSecretCopyName(source, c.Name), c.Namespace, c.GetLabels())
+}
@@ -0,0 +1,75 @@
+/**
+ * Copyright 2025 IBM Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package crutils_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	csiv1 "github.com/IBM/ibm-block-csi-operator/api/v1"
+	. "github.com/IBM/ibm-block-csi-operator/controllers/internal/crutils"
+	"github.com/IBM/ibm-block-csi-operator/pkg/config"
+)
+
+var _ = Describe("ImagePullSecrets", func() {
+	var ibcWrapper *IBMBlockCSI
+	source := csiv1.ImagePullSecretSource{Name: "registry", Namespace: "registry-secrets"}
+
+	BeforeEach(func() {
+		ibcWrapper = New(&csiv1.IBMBlockCSI{}, "1.28")
+		ibcWrapper.Name = "ibm-block-csi"
+		ibcWrapper.Namespace = "default"
+		ibcWrapper.Spec.ImagePullSecrets = []string{"local"}
+		ibcWrapper.Spec.ImagePullSecretSources = []csiv1.ImagePullSecretSource{source}
+	})
+
+	It("should attach the pull secrets and the copies of the sources to the ServiceAccounts", func() {
+		Expect(ibcWrapper.GetImagePullSecrets()).To(Equal([]string{"local", "ibm-block-csi-registry-secrets.registry"}))
+		for _, serviceAccount := range []*corev1.ServiceAccount{
+			ibcWrapper.GenerateControllerServiceAccount(),
+			ibcWrapper.GenerateNodeServiceAccount(),
+		} {
+			Expect(serviceAccount.ImagePullSecrets).To(Equal([]corev1.LocalObjectReference{
+				{Name: "local"}, {Name: "ibm-block-csi-registry-secrets.registry"}}))
+		}
+	})
+
+	It("should copy the type and the data of a source", func() {
+		secretCopy := ibcWrapper.GenerateImagePullSecretCopy(source, &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: source.Name, Namespace: source.Namespace},
+			Type:       corev1.SecretTypeDockerConfigJson,
+			Data:       map[string][]byte{corev1.DockerConfigJsonKey: []byte("{}")},
+		})
+		Expect(secretCopy.Name).To(Equal("ibm-block-csi-registry-secrets.registry"))
+		Expect(secretCopy.Namespace).To(Equal("default"))
+		Expect(secretCopy.Labels).To(HaveKeyWithValue(config.ImagePullSecretSourceLabel, source.Namespace))
+		Expect(secretCopy.Type).To(Equal(corev1.SecretTypeDockerConfigJson))
+		Expect(secretCopy.Data).To(HaveKey(corev1.DockerConfigJsonKey))
+	})
+
+	It("should refuse a source without a name or in the namespace of the IBMBlockCSI", func() {
+		Expect(ibcWrapper.Validate()).To(Succeed())
+
+		ibcWrapper.Spec.ImagePullSecretSources = []csiv1.ImagePullSecretSource{{Name: "registry"}}
+		Expect(ibcWrapper.Validate()).To(MatchError(ContainSubstring("must set name and namespace")))
+
+		ibcWrapper.Spec.ImagePullSecretSources = []csiv1.ImagePullSecretSource{{Name: "registry", Namespace: "default"}}
+		Expect(ibcWrapper.Validate()).To(MatchError(ContainSubstring("add it to imagePullSecrets")))
+	})
+})
@@ -0,0 +1,47 @@
+/**
+ * Copyright 2025 IBM Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package crutils
+
+import (
+	"github.com/IBM/ibm-block-csi-operator/pkg/config"
+)
+
+// getKubernetesFeatures returns the features of the Kubernetes version of the cluster
+func (c *IBMBlockCSI) getKubernetesFeatures() config.KubernetesFeatures {
+	if compatibility := config.GetKubernetesCompatibility(c.ServerVersion); compatibility != nil {
+		return compatibility.Features
+	}
+	return config.KubernetesFeatures{}
+}
+
+// GetSidecarArgs returns the args the Kubernetes version of the cluster adds to the sidecar container
+func (c *IBMBlockCSI) GetSidecarArgs(name string) []string {
+	if compatibility := config.GetKubernetesCompatibility(c.ServerVersion); compatibility != nil {
+		return compatibility.SidecarArgs[name]
+	}
+	return nil
+}
+
+// IsSELinuxMountEnabled returns true if the CSIDriver supports mounting with the SELinux context
+func (c *IBMBlockCSI) IsSELinuxMountEnabled() bool {
+	return c.getKubernetesFeatures().SELinuxMount
+}
+
+// IsVolumeGroupSnapshotsEnabled returns true if the csi-snapshotter handles volume group snapshots
+func (c *IBMBlockCSI) IsVolumeGroupSnapshotsEnabled() bool {
+	return c.getKubernetesFeatures().VolumeGroupSnapshots
+}
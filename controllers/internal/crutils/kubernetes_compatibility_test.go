@@ -0,0 +1,94 @@
+/**
+ * Copyright 2025 IBM Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package crutils_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	csiv1 "github.com/IBM/ibm-block-csi-operator/api/v1"
+	. "github.com/IBM/ibm-block-csi-operator/controllers/internal/crutils"
+	"github.com/IBM/ibm-block-csi-operator/pkg/config"
+)
+
+var _ = Describe("KubernetesCompatibility", func() {
+	oldSnapshotter := csiv1.CSISidecar{
+		Name:            config.CSISnapshotter,
+		Repository:      config.K8SRegistryUsername + "/csi-snapshotter",
+		Tag:             "v7.0.2",
+		ImagePullPolicy: "IfNotPresent",
+	}
+
+	BeforeEach(func() {
+		Expect(config.LoadDefaultsOfIBMBlockCSI()).To(Succeed())
+		config.DefaultKubernetesCompatibility = []config.KubernetesCompatibility{
+			{MaxVersion: "1.29", Sidecars: []csiv1.CSISidecar{oldSnapshotter}},
+			{
+				MinVersion:  "1.32",
+				SidecarArgs: map[string][]string{config.CSIProvisioner: {"--extra-create-metadata"}},
+				Features:    config.KubernetesFeatures{SELinuxMount: true, VolumeGroupSnapshots: true},
+			},
+		}
+	})
+
+	AfterEach(func() {
+		config.DefaultKubernetesCompatibility = nil
+	})
+
+	getSidecar := func(ibcWrapper *IBMBlockCSI, name string) csiv1.CSISidecar {
+		for _, sidecar := range ibcWrapper.Spec.Sidecars {
+			if sidecar.Name == name {
+				return sidecar
+			}
+		}
+		return csiv1.CSISidecar{}
+	}
+
+	It("should set the sidecars of the Kubernetes version", func() {
+		ibcWrapper := New(&csiv1.IBMBlockCSI{}, "1.29")
+		Expect(ibcWrapper.SetDefaults()).To(BeTrue())
+		Expect(ibcWrapper.Spec.Sidecars).To(HaveLen(len(config.DefaultIBMBlockCSICr.Spec.Sidecars)))
+		Expect(getSidecar(ibcWrapper, config.CSISnapshotter)).To(Equal(oldSnapshotter))
+		Expect(ibcWrapper.IsSELinuxMountEnabled()).To(BeFalse())
+	})
+
+	It("should change the sidecars after a cluster upgrade", func() {
+		ibcWrapper := New(&csiv1.IBMBlockCSI{}, "1.29")
+		ibcWrapper.SetDefaults()
+		Expect(ibcWrapper.SetDefaults()).To(BeFalse())
+
+		ibcWrapper.ServerVersion = "1.30"
+		Expect(ibcWrapper.SetDefaults()).To(BeTrue())
+		Expect(getSidecar(ibcWrapper, config.CSISnapshotter)).To(Equal(config.DefaultSidecarsByName[config.CSISnapshotter]))
+	})
+
+	It("should set the args and features of the Kubernetes version", func() {
+		ibcWrapper := New(&csiv1.IBMBlockCSI{}, "1.33+")
+		Expect(ibcWrapper.GetSidecarArgs(config.CSIProvisioner)).To(Equal([]string{"--extra-create-metadata"}))
+		Expect(ibcWrapper.GetSidecarArgs(config.CSIAttacher)).To(BeEmpty())
+		Expect(ibcWrapper.IsSELinuxMountEnabled()).To(BeTrue())
+		Expect(*ibcWrapper.GenerateCSIDriver().Spec.SELinuxMount).To(BeTrue())
+		Expect(ibcWrapper.GenerateExternalSnapshotterClusterRole().Rules).To(ContainElement(
+			HaveField("APIGroups", ConsistOf("groupsnapshot.storage.k8s.io"))))
+	})
+
+	It("should use the defaults when the Kubernetes version is unknown", func() {
+		ibcWrapper := New(&csiv1.IBMBlockCSI{}, "")
+		Expect(ibcWrapper.IsVolumeGroupSnapshotsEnabled()).To(BeFalse())
+		Expect(ibcWrapper.GenerateCSIDriver().Spec.SELinuxMount).To(BeNil())
+	})
+})
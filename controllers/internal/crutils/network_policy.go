@@ -0,0 +1,50 @@
+/**
+ * Copyright 2025 IBM Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package crutils
+
+import (
+	networkingv1 "k8s.io/api/networking/v1"
+
+	"github.com/IBM/ibm-block-csi-operator/controllers/internal/common"
+	"github.com/IBM/ibm-block-csi-operator/pkg/config"
+)
+
+// GetHealthPort returns the health port of the controller and the node plugins
+func (c *IBMBlockCSI) GetHealthPort() int32 {
+	if c.Spec.HealthPort == 0 {
+		return config.DefaultHealthPort
+	}
+	return int32(c.Spec.HealthPort)
+}
+
+// IsNetworkPolicyEnabled returns true if a NetworkPolicy is generated for the controller pods
+func (c *IBMBlockCSI) IsNetworkPolicyEnabled() bool {
+	return common.IsNetworkPolicyEnabled(c.Spec.NetworkPolicy)
+}
+
+// GenerateControllerNetworkPolicy returns the NetworkPolicy of the controller pods, which allows the ingress
+// to the health and the metrics ports, and the egress through the proxy of the call home, nil if it is not enabled
+func (c *IBMBlockCSI) GenerateControllerNetworkPolicy() *networkingv1.NetworkPolicy {
+	if !c.IsNetworkPolicyEnabled() {
+		return nil
+	}
+	ingressPorts := append([]int32{c.GetHealthPort()}, c.Spec.NetworkPolicy.MetricsPorts...)
+	return common.GenerateNetworkPolicy(config.GetNameForResource(config.CSIControllerNetworkPolicy, c.Name),
+		c.Namespace, c.GetLabels(), c.GetCSIControllerSelectorLabels(), ingressPorts,
+		common.GetNetworkPolicyDestinations(c.APIServerDestination, c.GetCallHomeProxy(),
+			common.MergeArrayCIDRs(c.Spec.NetworkPolicy, c.ArraySecretCIDRs)))
+}
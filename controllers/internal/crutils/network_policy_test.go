@@ -0,0 +1,91 @@
+/**
+ * Copyright 2025 IBM Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package crutils_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	networkingv1 "k8s.io/api/networking/v1"
+
+	csiv1 "github.com/IBM/ibm-block-csi-operator/api/v1"
+	. "github.com/IBM/ibm-block-csi-operator/controllers/internal/crutils"
+	"github.com/IBM/ibm-block-csi-operator/pkg/config"
+)
+
+var _ = Describe("NetworkPolicy", func() {
+	var ibcWrapper *IBMBlockCSI
+
+	BeforeEach(func() {
+		ibcWrapper = New(&csiv1.IBMBlockCSI{}, "1.28")
+		ibcWrapper.Name = "ibm-block-csi"
+		ibcWrapper.Namespace = "default"
+	})
+
+	It("should not generate a NetworkPolicy unless it is enabled", func() {
+		Expect(ibcWrapper.GenerateControllerNetworkPolicy()).To(BeNil())
+		ibcWrapper.Spec.NetworkPolicy = &csiv1.NetworkPolicy{}
+		Expect(ibcWrapper.GenerateControllerNetworkPolicy()).To(BeNil())
+	})
+
+	It("should allow the health and metrics ports and the array CIDRs", func() {
+		ibcWrapper.Spec.NetworkPolicy = &csiv1.NetworkPolicy{
+			Enabled:      true,
+			ArrayCIDRs:   []string{"10.0.0.0/24"},
+			MetricsPorts: []int32{8080},
+		}
+		ibcWrapper.ArraySecretCIDRs = []string{"10.0.1.5/32", "10.0.0.0/24"}
+
+		networkPolicy := ibcWrapper.GenerateControllerNetworkPolicy()
+		Expect(networkPolicy.Spec.PolicyTypes).To(ConsistOf(networkingv1.PolicyTypeIngress, networkingv1.PolicyTypeEgress))
+		Expect(networkPolicy.Spec.Ingress).To(HaveLen(1))
+		var ingressPorts []int32
+		for _, port := range networkPolicy.Spec.Ingress[0].Ports {
+			ingressPorts = append(ingressPorts, port.Port.IntVal)
+		}
+		Expect(ingressPorts).To(ConsistOf(int32(config.DefaultHealthPort), int32(8080)))
+
+		var arrayCIDRs []string
+		for _, rule := range networkPolicy.Spec.Egress {
+			for _, peer := range rule.To {
+				if peer.IPBlock != nil {
+					arrayCIDRs = append(arrayCIDRs, peer.IPBlock.CIDR)
+				}
+			}
+		}
+		Expect(arrayCIDRs).To(ConsistOf("10.0.0.0/24", "10.0.1.5/32"))
+	})
+
+	It("should allow the egress to the proxy of the call home", func() {
+		ibcWrapper.Spec.NetworkPolicy = &csiv1.NetworkPolicy{Enabled: true}
+		ibcWrapper.ClusterProxy = &csiv1.ProxyConfig{HTTPSProxy: "http://10.0.2.1:3128"}
+
+		var proxyCIDRs []string
+		for _, rule := range ibcWrapper.GenerateControllerNetworkPolicy().Spec.Egress {
+			for _, peer := range rule.To {
+				if peer.IPBlock != nil {
+					proxyCIDRs = append(proxyCIDRs, peer.IPBlock.CIDR)
+				}
+			}
+		}
+		Expect(proxyCIDRs).To(ConsistOf("10.0.2.1/32"))
+	})
+
+	It("should refuse an invalid array CIDR", func() {
+		ibcWrapper.Spec.NetworkPolicy = &csiv1.NetworkPolicy{Enabled: true, ArrayCIDRs: []string{"10.0.0.1"}}
+		Expect(ibcWrapper.Validate()).To(MatchError(ContainSubstring("networkPolicy.arrayCIDRs")))
+	})
+})
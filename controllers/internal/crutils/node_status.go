@@ -0,0 +1,192 @@
+/**
+ * Copyright 2025 IBM Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package crutils
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	csiv1 "github.com/IBM/ibm-block-csi-operator/api/v1"
+	"github.com/IBM/ibm-block-csi-operator/pkg/config"
+)
+
+// MaxNodesInStatus is the max number of nodes a status message lists, the rest are counted
+const MaxNodesInStatus = 10
+
+const (
+	unhealthyReasonCrashLoopBackOff = "CrashLoopBackOff"
+	unhealthyReasonImageMismatch    = "ImageMismatch"
+	unhealthyReasonNotReady         = "NotReady"
+)
+
+// SetNodePluginStatus sets the readiness breakdown of the node plugin from its DaemonSet and pods,
+// nodeDaemonSet is nil when the node is not deployed
+func (c *IBMBlockCSI) SetNodePluginStatus(nodeDaemonSet *appsv1.DaemonSet, nodePods []corev1.Pod) {
+	if nodeDaemonSet == nil {
+		c.Status.NodePlugin = nil
+		return
+	}
+
+	var unhealthyNodes []csiv1.UnhealthyNode
+	for _, pod := range nodePods {
+		if unhealthyNode := getUnhealthyNode(nodeDaemonSet, pod); unhealthyNode != nil {
+			unhealthyNodes = append(unhealthyNodes, *unhealthyNode)
+		}
+	}
+	sort.Slice(unhealthyNodes, func(i, j int) bool {
+		return unhealthyNodes[i].NodeName < unhealthyNodes[j].NodeName
+	})
+
+	nodePluginStatus := &csiv1.NodePluginStatus{
+		DesiredNumber:   nodeDaemonSet.Status.DesiredNumberScheduled,
+		ReadyNumber:     nodeDaemonSet.Status.NumberReady,
+		UpdatedNumber:   nodeDaemonSet.Status.UpdatedNumberScheduled,
+		UnhealthyNumber: int32(len(unhealthyNodes)),
+	}
+	if len(unhealthyNodes) > MaxNodesInStatus {
+		unhealthyNodes = unhealthyNodes[:MaxNodesInStatus]
+	}
+	nodePluginStatus.UnhealthyNodes = unhealthyNodes
+	c.Status.NodePlugin = nodePluginStatus
+}
+
+// getUnhealthyNode returns why the node plugin pod is unhealthy, nil when it is healthy
+func getUnhealthyNode(nodeDaemonSet *appsv1.DaemonSet, pod corev1.Pod) *csiv1.UnhealthyNode {
+	unhealthyNode := &csiv1.UnhealthyNode{
+		NodeName: pod.Spec.NodeName,
+		PodName:  pod.Name,
+	}
+
+	for _, status := range pod.Status.ContainerStatuses {
+		if status.State.Waiting != nil && status.State.Waiting.Reason == unhealthyReasonCrashLoopBackOff {
+			unhealthyNode.Reason = unhealthyReasonCrashLoopBackOff
+			unhealthyNode.Message = fmt.Sprintf("container %s is in CrashLoopBackOff", status.Name)
+			return unhealthyNode
+		}
+	}
+
+	for _, desiredContainer := range nodeDaemonSet.Spec.Template.Spec.Containers {
+		for _, container := range pod.Spec.Containers {
+			if container.Name == desiredContainer.Name && container.Image != desiredContainer.Image {
+				unhealthyNode.Reason = unhealthyReasonImageMismatch
+				unhealthyNode.Message = fmt.Sprintf("container %s runs %s instead of %s",
+					container.Name, container.Image, desiredContainer.Image)
+				return unhealthyNode
+			}
+		}
+	}
+
+	for _, condition := range pod.Status.Conditions {
+		if condition.Type == corev1.PodReady && condition.Status == corev1.ConditionTrue {
+			return nil
+		}
+	}
+	unhealthyNode.Reason = unhealthyReasonNotReady
+	unhealthyNode.Message = fmt.Sprintf("pod is not ready in phase %s", pod.Status.Phase)
+	return unhealthyNode
+}
+
+// SetNodePrerequisitesCondition sets the NodePrerequisitesMet condition from the preflight
+// results of the node plugin pods
+func (c *IBMBlockCSI) SetNodePrerequisitesCondition(nodePods []corev1.Pod) {
+	if c.GetNodeManagementState() == csiv1.ManagementStateRemoved {
+		meta.RemoveStatusCondition(&c.Status.Conditions, csiv1.ConditionNodePrerequisitesMet)
+		return
+	}
+
+	failuresByNode := map[string]string{}
+	checkedNodes := 0
+	for _, pod := range nodePods {
+		failures, checked := getPreflightFailures(pod)
+		if !checked {
+			continue
+		}
+		checkedNodes++
+		if len(failures) > 0 {
+			failuresByNode[pod.Spec.NodeName] = strings.Join(failures, ", ")
+		}
+	}
+
+	condition := metav1.Condition{
+		Type:               csiv1.ConditionNodePrerequisitesMet,
+		ObservedGeneration: c.Generation,
+	}
+	switch {
+	case checkedNodes == 0:
+		condition.Status = metav1.ConditionUnknown
+		condition.Reason = "PreflightPending"
+		condition.Message = "no node finished the preflight checks"
+	case len(failuresByNode) > 0:
+		condition.Status = metav1.ConditionFalse
+		condition.Reason = "PrerequisitesMissing"
+		condition.Message = getNodesMessage(failuresByNode)
+	default:
+		condition.Status = metav1.ConditionTrue
+		condition.Reason = "PrerequisitesMet"
+		condition.Message = fmt.Sprintf("the preflight checks passed on %d nodes", checkedNodes)
+	}
+	meta.SetStatusCondition(&c.Status.Conditions, condition)
+}
+
+// getPreflightFailures returns the failed preflight checks of a pod, and whether the checks finished
+func getPreflightFailures(pod corev1.Pod) ([]string, bool) {
+	for _, status := range pod.Status.InitContainerStatuses {
+		if status.Name != config.NodePreflightContainerName {
+			continue
+		}
+		terminated := status.State.Terminated
+		if terminated == nil {
+			terminated = status.LastTerminationState.Terminated
+		}
+		if terminated == nil {
+			return nil, false
+		}
+		var failures []string
+		for _, line := range strings.Split(terminated.Message, "\n") {
+			if line = strings.TrimSpace(line); line != "" {
+				failures = append(failures, line)
+			}
+		}
+		return failures, true
+	}
+	return nil, false
+}
+
+// getNodesMessage lists up to MaxNodesInStatus nodes with their details
+func getNodesMessage(detailsByNode map[string]string) string {
+	nodeNames := make([]string, 0, len(detailsByNode))
+	for nodeName := range detailsByNode {
+		nodeNames = append(nodeNames, nodeName)
+	}
+	sort.Strings(nodeNames)
+
+	var nodes []string
+	for i, nodeName := range nodeNames {
+		if i == MaxNodesInStatus {
+			nodes = append(nodes, fmt.Sprintf("and %d more nodes", len(nodeNames)-MaxNodesInStatus))
+			break
+		}
+		nodes = append(nodes, fmt.Sprintf("%s: %s", nodeName, detailsByNode[nodeName]))
+	}
+	return strings.Join(nodes, "; ")
+}
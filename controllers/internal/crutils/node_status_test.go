@@ -0,0 +1,173 @@
+/**
+ * Copyright 2025 IBM Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package crutils_test
+
+import (
+	"fmt"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	csiv1 "github.com/IBM/ibm-block-csi-operator/api/v1"
+	. "github.com/IBM/ibm-block-csi-operator/controllers/internal/crutils"
+	"github.com/IBM/ibm-block-csi-operator/pkg/config"
+)
+
+func getNodePod(nodeName string, preflightMessage *string) corev1.Pod {
+	pod := corev1.Pod{Spec: corev1.PodSpec{NodeName: nodeName}}
+	status := corev1.ContainerStatus{Name: config.NodePreflightContainerName}
+	if preflightMessage != nil {
+		status.State.Terminated = &corev1.ContainerStateTerminated{Message: *preflightMessage}
+	}
+	pod.Status.InitContainerStatuses = []corev1.ContainerStatus{status}
+	return pod
+}
+
+func stringPtr(s string) *string {
+	return &s
+}
+
+var _ = Describe("NodePrerequisitesMet", func() {
+	var ibcWrapper *IBMBlockCSI
+
+	BeforeEach(func() {
+		ibcWrapper = New(&csiv1.IBMBlockCSI{}, "1.13")
+	})
+
+	getCondition := func() *metav1.Condition {
+		return meta.FindStatusCondition(ibcWrapper.Status.Conditions, csiv1.ConditionNodePrerequisitesMet)
+	}
+
+	It("should be unknown until a node finishes the preflight checks", func() {
+		ibcWrapper.SetNodePrerequisitesCondition([]corev1.Pod{getNodePod("node-a", nil)})
+		Expect(getCondition().Status).To(Equal(metav1.ConditionUnknown))
+	})
+
+	It("should be true when the preflight checks pass on all the nodes", func() {
+		ibcWrapper.SetNodePrerequisitesCondition([]corev1.Pod{
+			getNodePod("node-a", stringPtr("")),
+			getNodePod("node-b", nil),
+		})
+		Expect(getCondition().Status).To(Equal(metav1.ConditionTrue))
+	})
+
+	It("should list the failing nodes with their failed checks", func() {
+		ibcWrapper.SetNodePrerequisitesCondition([]corev1.Pod{
+			getNodePod("node-b", stringPtr("multipathd is not running\n/etc/nvme/hostnqn is missing\n")),
+			getNodePod("node-a", stringPtr("iscsid is not running\n")),
+			getNodePod("node-c", stringPtr("")),
+		})
+		condition := getCondition()
+		Expect(condition.Status).To(Equal(metav1.ConditionFalse))
+		Expect(condition.Message).To(Equal(
+			"node-a: iscsid is not running; node-b: multipathd is not running, /etc/nvme/hostnqn is missing"))
+	})
+
+	It("should cap the number of listed nodes", func() {
+		var pods []corev1.Pod
+		for i := 0; i < MaxNodesInStatus+2; i++ {
+			pods = append(pods, getNodePod(fmt.Sprintf("node-%02d", i), stringPtr("iscsid is not running")))
+		}
+		ibcWrapper.SetNodePrerequisitesCondition(pods)
+		Expect(getCondition().Message).To(HaveSuffix("; and 2 more nodes"))
+		Expect(getCondition().Message).NotTo(ContainSubstring("node-10"))
+	})
+
+	It("should be removed when the node is removed", func() {
+		ibcWrapper.SetNodePrerequisitesCondition([]corev1.Pod{getNodePod("node-a", stringPtr(""))})
+		ibcWrapper.Spec.Node.ManagementState = csiv1.ManagementStateRemoved
+		ibcWrapper.SetNodePrerequisitesCondition(nil)
+		Expect(getCondition()).To(BeNil())
+	})
+})
+
+var _ = Describe("NodePluginStatus", func() {
+	var ibcWrapper *IBMBlockCSI
+	var nodeDaemonSet *appsv1.DaemonSet
+
+	getPod := func(nodeName string, image string, ready bool) corev1.Pod {
+		pod := corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{Name: "pod-" + nodeName},
+			Spec: corev1.PodSpec{
+				NodeName:   nodeName,
+				Containers: []corev1.Container{{Name: "node", Image: image}},
+			},
+			Status: corev1.PodStatus{Phase: corev1.PodRunning},
+		}
+		if ready {
+			pod.Status.Conditions = []corev1.PodCondition{{Type: corev1.PodReady, Status: corev1.ConditionTrue}}
+		}
+		return pod
+	}
+
+	BeforeEach(func() {
+		ibcWrapper = New(&csiv1.IBMBlockCSI{}, "1.13")
+		nodeDaemonSet = &appsv1.DaemonSet{
+			Spec: appsv1.DaemonSetSpec{Template: corev1.PodTemplateSpec{Spec: corev1.PodSpec{
+				Containers: []corev1.Container{{Name: "node", Image: "node:2"}},
+			}}},
+			Status: appsv1.DaemonSetStatus{DesiredNumberScheduled: 4, NumberReady: 2, UpdatedNumberScheduled: 3},
+		}
+	})
+
+	It("should report the counts and the unhealthy nodes", func() {
+		crashing := getPod("node-c", "node:2", false)
+		crashing.Status.ContainerStatuses = []corev1.ContainerStatus{{
+			Name:  "node",
+			State: corev1.ContainerState{Waiting: &corev1.ContainerStateWaiting{Reason: "CrashLoopBackOff"}},
+		}}
+
+		ibcWrapper.SetNodePluginStatus(nodeDaemonSet, []corev1.Pod{
+			getPod("node-d", "node:2", true),
+			crashing,
+			getPod("node-b", "node:1", true),
+			getPod("node-a", "node:2", false),
+		})
+
+		nodePlugin := ibcWrapper.Status.NodePlugin
+		Expect(nodePlugin.DesiredNumber).To(Equal(int32(4)))
+		Expect(nodePlugin.ReadyNumber).To(Equal(int32(2)))
+		Expect(nodePlugin.UpdatedNumber).To(Equal(int32(3)))
+		Expect(nodePlugin.UnhealthyNumber).To(Equal(int32(3)))
+		Expect(nodePlugin.UnhealthyNodes).To(HaveLen(3))
+		Expect(nodePlugin.UnhealthyNodes[0].Reason).To(Equal("NotReady"))
+		Expect(nodePlugin.UnhealthyNodes[1].Reason).To(Equal("ImageMismatch"))
+		Expect(nodePlugin.UnhealthyNodes[1].Message).To(Equal("container node runs node:1 instead of node:2"))
+		Expect(nodePlugin.UnhealthyNodes[2].Reason).To(Equal("CrashLoopBackOff"))
+	})
+
+	It("should cap the number of listed unhealthy nodes", func() {
+		var pods []corev1.Pod
+		for i := 0; i < MaxNodesInStatus+5; i++ {
+			pods = append(pods, getPod(fmt.Sprintf("node-%02d", i), "node:2", false))
+		}
+
+		ibcWrapper.SetNodePluginStatus(nodeDaemonSet, pods)
+		Expect(ibcWrapper.Status.NodePlugin.UnhealthyNumber).To(Equal(int32(MaxNodesInStatus + 5)))
+		Expect(ibcWrapper.Status.NodePlugin.UnhealthyNodes).To(HaveLen(MaxNodesInStatus))
+	})
+
+	It("should be cleared without a node DaemonSet", func() {
+		ibcWrapper.SetNodePluginStatus(nodeDaemonSet, nil)
+		ibcWrapper.SetNodePluginStatus(nil, nil)
+		Expect(ibcWrapper.Status.NodePlugin).To(BeNil())
+	})
+})
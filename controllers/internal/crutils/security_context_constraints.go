@@ -0,0 +1,35 @@
+/**
+ * Copyright 2025 IBM Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package crutils
+
+import (
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/IBM/ibm-block-csi-operator/pkg/config"
+)
+
+// SecurityContextConstraintsGroupVersionKind is the GroupVersionKind of the OpenShift SecurityContextConstraints
+var SecurityContextConstraintsGroupVersionKind = schema.GroupVersionKind{
+	Group:   config.SecurityContextConstraintsApiGroup,
+	Version: config.SecurityContextConstraintsVersion,
+	Kind:    config.SecurityContextConstraintsKind,
+}
+
+// GetCSINodeSCCName returns the name of the SecurityContextConstraints generated for the node plugin pods
+func (c *IBMBlockCSI) GetCSINodeSCCName() string {
+	return config.CSINodeSCCName
+}
@@ -0,0 +1,149 @@
+/**
+ * Copyright 2025 IBM Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package crutils
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	csiv1 "github.com/IBM/ibm-block-csi-operator/api/v1"
+	"github.com/IBM/ibm-block-csi-operator/pkg/config"
+)
+
+// SidecarAPI is an API a sidecar needs the cluster to serve
+type SidecarAPI struct {
+	schema.GroupVersionKind
+	// Resource is the plural name of the kind
+	Resource string
+}
+
+// GetCRDName returns the name of the CRD of the API
+func (a SidecarAPI) GetCRDName() string {
+	return fmt.Sprintf("%s.%s", a.Resource, a.Group)
+}
+
+// SidecarAPIs are the APIs of the optional sidecars, by sidecar name
+var SidecarAPIs = map[string][]SidecarAPI{
+	config.CSISnapshotter: {
+		{GroupVersionKind: schema.GroupVersionKind{Group: snapshotStorageApiGroup, Version: "v1", Kind: "VolumeSnapshot"},
+			Resource: volumeSnapshotsResource},
+		{GroupVersionKind: schema.GroupVersionKind{Group: snapshotStorageApiGroup, Version: "v1", Kind: "VolumeSnapshotContent"},
+			Resource: volumeSnapshotContentsResource},
+		{GroupVersionKind: schema.GroupVersionKind{Group: snapshotStorageApiGroup, Version: "v1", Kind: "VolumeSnapshotClass"},
+			Resource: volumeSnapshotClassesResource},
+	},
+	config.CSIAddonsReplicator: {
+		{GroupVersionKind: schema.GroupVersionKind{Group: replicationStorageOpenshiftApiGroup, Version: "v1alpha1",
+			Kind: "VolumeReplication"}, Resource: volumeReplicationsResource},
+		{GroupVersionKind: schema.GroupVersionKind{Group: replicationStorageOpenshiftApiGroup, Version: "v1alpha1",
+			Kind: "VolumeReplicationClass"}, Resource: volumeReplicationClassesResource},
+	},
+	config.CSIVolumeGroup: {
+		{GroupVersionKind: schema.GroupVersionKind{Group: volumeGroupApiGroup, Version: "v1", Kind: "VolumeGroup"},
+			Resource: volumeGroupsResources},
+		{GroupVersionKind: schema.GroupVersionKind{Group: volumeGroupApiGroup, Version: "v1", Kind: "VolumeGroupContent"},
+			Resource: volumeGroupContentsResource},
+		{GroupVersionKind: schema.GroupVersionKind{Group: volumeGroupApiGroup, Version: "v1", Kind: "VolumeGroupClass"},
+			Resource: volumeGroupClassesResource},
+	},
+}
+
+// alwaysEnabledSidecars are the sidecars which do not honor enabled
+var alwaysEnabledSidecars = []string{config.LivenessProbe, config.CSINodeDriverRegistrar}
+
+// GetSidecarEnabled returns whether the sidecar is enabled in the spec, or else in the
+// default sidecars, auto if not set
+func (c *IBMBlockCSI) GetSidecarEnabled(name string) csiv1.SidecarEnabled {
+	for _, sidecar := range c.Spec.Sidecars {
+		if sidecar.Name == name {
+			if sidecar.Enabled != "" {
+				return sidecar.Enabled
+			}
+			return csiv1.SidecarEnabledAuto
+		}
+	}
+	for _, sidecar := range config.GetDefaultSidecars(c.ServerVersion) {
+		if sidecar.Name == name && sidecar.Enabled != "" {
+			return sidecar.Enabled
+		}
+	}
+	return csiv1.SidecarEnabledAuto
+}
+
+// IsSidecarEnabled returns true if the sidecar is deployed, an auto sidecar is
+// unless the cluster does not serve all of its APIs
+func (c *IBMBlockCSI) IsSidecarEnabled(name string) bool {
+	for _, alwaysEnabled := range alwaysEnabledSidecars {
+		if name == alwaysEnabled {
+			return true
+		}
+	}
+
+	switch c.GetSidecarEnabled(name) {
+	case csiv1.SidecarEnabledTrue:
+		return true
+	case csiv1.SidecarEnabledFalse:
+		return false
+	}
+	return len(c.MissingSidecarAPIs[name]) == 0
+}
+
+// SetSidecarAPIsCondition sets the SidecarAPIsAvailable condition from the missing APIs of the sidecars which are not disabled
+func (c *IBMBlockCSI) SetSidecarAPIsCondition() {
+	condition := metav1.Condition{
+		Type:               csiv1.ConditionSidecarAPIsAvailable,
+		ObservedGeneration: c.Generation,
+		Status:             metav1.ConditionTrue,
+		Reason:             "APIsAvailable",
+		Message:            "the cluster serves the APIs of all the enabled sidecars",
+	}
+
+	var sidecarNames []string
+	for name := range c.MissingSidecarAPIs {
+		sidecarNames = append(sidecarNames, name)
+	}
+	sort.Strings(sidecarNames)
+
+	var messages []string
+	for _, name := range sidecarNames {
+		missingCRDs := c.MissingSidecarAPIs[name]
+		if len(missingCRDs) == 0 {
+			continue
+		}
+		switch c.GetSidecarEnabled(name) {
+		case csiv1.SidecarEnabledFalse:
+			continue
+		case csiv1.SidecarEnabledTrue:
+			messages = append(messages, fmt.Sprintf("%s is enabled, but the CRDs %s are missing",
+				name, strings.Join(missingCRDs, ", ")))
+		default:
+			messages = append(messages, fmt.Sprintf("%s is disabled, the CRDs %s are missing",
+				name, strings.Join(missingCRDs, ", ")))
+		}
+	}
+	if len(messages) > 0 {
+		condition.Status = metav1.ConditionFalse
+		condition.Reason = "APIsMissing"
+		condition.Message = strings.Join(messages, "; ")
+	}
+	meta.SetStatusCondition(&c.Status.Conditions, condition)
+}
@@ -0,0 +1,91 @@
+/**
+ * Copyright 2025 IBM Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package crutils_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	csiv1 "github.com/IBM/ibm-block-csi-operator/api/v1"
+	. "github.com/IBM/ibm-block-csi-operator/controllers/internal/crutils"
+	"github.com/IBM/ibm-block-csi-operator/pkg/config"
+)
+
+var _ = Describe("Sidecars", func() {
+	var ibcWrapper *IBMBlockCSI
+
+	BeforeEach(func() {
+		ibcWrapper = New(&csiv1.IBMBlockCSI{}, "1.13")
+		ibcWrapper.MissingSidecarAPIs = map[string][]string{
+			config.CSISnapshotter: {"volumesnapshots.snapshot.storage.k8s.io"},
+		}
+	})
+
+	setEnabled := func(name string, enabled csiv1.SidecarEnabled) {
+		ibcWrapper.Spec.Sidecars = append(ibcWrapper.Spec.Sidecars, csiv1.CSISidecar{Name: name, Enabled: enabled})
+	}
+
+	getCondition := func() *metav1.Condition {
+		ibcWrapper.SetSidecarAPIsCondition()
+		return meta.FindStatusCondition(ibcWrapper.Status.Conditions, csiv1.ConditionSidecarAPIsAvailable)
+	}
+
+	It("should disable an auto sidecar whose APIs are missing", func() {
+		Expect(ibcWrapper.IsSidecarEnabled(config.CSISnapshotter)).To(BeFalse())
+		Expect(ibcWrapper.IsSidecarEnabled(config.CSIVolumeGroup)).To(BeTrue())
+		condition := getCondition()
+		Expect(condition.Status).To(Equal(metav1.ConditionFalse))
+		Expect(condition.Message).To(Equal(
+			"csi-snapshotter is disabled, the CRDs volumesnapshots.snapshot.storage.k8s.io are missing"))
+	})
+
+	It("should deploy an enabled sidecar whose APIs are missing", func() {
+		setEnabled(config.CSISnapshotter, csiv1.SidecarEnabledTrue)
+		Expect(ibcWrapper.IsSidecarEnabled(config.CSISnapshotter)).To(BeTrue())
+		Expect(getCondition().Message).To(ContainSubstring("csi-snapshotter is enabled"))
+	})
+
+	It("should not deploy a disabled sidecar", func() {
+		setEnabled(config.CSIVolumeGroup, csiv1.SidecarEnabledFalse)
+		setEnabled(config.CSISnapshotter, csiv1.SidecarEnabledFalse)
+		Expect(ibcWrapper.IsSidecarEnabled(config.CSIVolumeGroup)).To(BeFalse())
+		Expect(getCondition().Status).To(Equal(metav1.ConditionTrue))
+	})
+
+	It("should always deploy the livenessprobe", func() {
+		setEnabled(config.LivenessProbe, csiv1.SidecarEnabledFalse)
+		Expect(ibcWrapper.IsSidecarEnabled(config.LivenessProbe)).To(BeTrue())
+	})
+
+	It("should not deploy the health monitor by default", func() {
+		Expect(config.LoadDefaultsOfIBMBlockCSI()).To(Succeed())
+		Expect(ibcWrapper.IsSidecarEnabled(config.CSIHealthMonitorController)).To(BeFalse())
+		ibcWrapper.SetDefaults()
+		Expect(ibcWrapper.IsSidecarEnabled(config.CSIHealthMonitorController)).To(BeFalse())
+	})
+
+	It("should keep the enabled of the sidecars when it sets the defaults", func() {
+		Expect(config.LoadDefaultsOfIBMBlockCSI()).To(Succeed())
+		setEnabled(config.CSIAddonsReplicator, csiv1.SidecarEnabledFalse)
+		Expect(ibcWrapper.SetDefaults()).To(BeTrue())
+		Expect(ibcWrapper.Spec.Sidecars).To(HaveLen(len(config.DefaultIBMBlockCSICr.Spec.Sidecars)))
+		Expect(ibcWrapper.GetSidecarEnabled(config.CSIAddonsReplicator)).To(Equal(csiv1.SidecarEnabledFalse))
+		Expect(ibcWrapper.SetDefaults()).To(BeFalse())
+	})
+})
@@ -17,6 +17,7 @@
 package crutils
 
 import (
+	"github.com/IBM/ibm-block-csi-operator/controllers/internal/common"
 	"github.com/IBM/ibm-block-csi-operator/pkg/config"
 	"github.com/IBM/ibm-block-csi-operator/pkg/util/boolptr"
 	corev1 "k8s.io/api/core/v1"
@@ -68,8 +69,22 @@ const (
 	verbDelete                               string = "delete"
 )
 
+const (
+	appsApiGroup                 string = "apps"
+	statefulSetsResource         string = "statefulsets"
+	replicaSetsResource          string = "replicasets"
+	csiStorageCapacitiesResource string = "csistoragecapacities"
+)
+
+const (
+	groupSnapshotStorageApiGroup              string = "groupsnapshot.storage.k8s.io"
+	volumeGroupSnapshotClassesResource        string = "volumegroupsnapshotclasses"
+	volumeGroupSnapshotContentsResource       string = "volumegroupsnapshotcontents"
+	volumeGroupSnapshotContentsStatusResource string = "volumegroupsnapshotcontents/status"
+)
+
 func (c *IBMBlockCSI) GenerateCSIDriver() *storagev1.CSIDriver {
-	return &storagev1.CSIDriver{
+	csiDriver := &storagev1.CSIDriver{
 		ObjectMeta: metav1.ObjectMeta{
 			Name: config.DriverName,
 		},
@@ -78,6 +93,77 @@ func (c *IBMBlockCSI) GenerateCSIDriver() *storagev1.CSIDriver {
 			PodInfoOnMount: boolptr.False(),
 		},
 	}
+	if c.IsSELinuxMountEnabled() {
+		csiDriver.Spec.SELinuxMount = boolptr.True()
+	}
+	if c.IsCapacityTrackingEnabled() {
+		csiDriver.Spec.StorageCapacity = boolptr.True()
+	}
+	if options := c.Spec.CSIDriver; options != nil {
+		if options.PodInfoOnMount != nil {
+			csiDriver.Spec.PodInfoOnMount = options.PodInfoOnMount
+		}
+		if options.SELinuxMount != nil {
+			csiDriver.Spec.SELinuxMount = options.SELinuxMount
+		}
+		csiDriver.Spec.FSGroupPolicy = options.FSGroupPolicy
+		csiDriver.Spec.VolumeLifecycleModes = options.VolumeLifecycleModes
+		csiDriver.Spec.RequiresRepublish = options.RequiresRepublish
+		csiDriver.Spec.TokenRequests = options.TokenRequests
+	}
+	return csiDriver
+}
+
+// GenerateClusterRoles returns all the ClusterRoles of the CSI driver,
+// the ClusterRoles which use SecurityContextConstraints only when the cluster serves them
+func (c *IBMBlockCSI) GenerateClusterRoles() []*rbacv1.ClusterRole {
+	clusterRoles := []*rbacv1.ClusterRole{
+		c.GenerateExternalProvisionerClusterRole(),
+		c.GenerateExternalAttacherClusterRole(),
+		c.GenerateExternalSnapshotterClusterRole(),
+		c.GenerateExternalResizerClusterRole(),
+		c.GenerateExternalHealthMonitorClusterRole(),
+		c.GenerateCSIAddonsReplicatorClusterRole(),
+		c.GenerateVolumeGroupClusterRole(),
+	}
+	if c.SecurityContextConstraintsAvailable {
+		clusterRoles = append(clusterRoles, c.GenerateSCCClusterRoles()...)
+	}
+	return clusterRoles
+}
+
+// GenerateSCCClusterRoles returns the ClusterRoles which use SecurityContextConstraints
+func (c *IBMBlockCSI) GenerateSCCClusterRoles() []*rbacv1.ClusterRole {
+	return []*rbacv1.ClusterRole{
+		c.GenerateSCCForControllerClusterRole(),
+		c.GenerateSCCForNodeClusterRole(),
+	}
+}
+
+// GenerateClusterRoleBindings returns all the ClusterRoleBindings of the CSI driver,
+// the ClusterRoleBindings which use SecurityContextConstraints only when the cluster serves them
+func (c *IBMBlockCSI) GenerateClusterRoleBindings() []*rbacv1.ClusterRoleBinding {
+	clusterRoleBindings := []*rbacv1.ClusterRoleBinding{
+		c.GenerateExternalProvisionerClusterRoleBinding(),
+		c.GenerateExternalAttacherClusterRoleBinding(),
+		c.GenerateExternalSnapshotterClusterRoleBinding(),
+		c.GenerateExternalResizerClusterRoleBinding(),
+		c.GenerateExternalHealthMonitorClusterRoleBinding(),
+		c.GenerateCSIAddonsReplicatorClusterRoleBinding(),
+		c.GenerateVolumeGroupClusterRoleBinding(),
+	}
+	if c.SecurityContextConstraintsAvailable {
+		clusterRoleBindings = append(clusterRoleBindings, c.GenerateSCCClusterRoleBindings()...)
+	}
+	return clusterRoleBindings
+}
+
+// GenerateSCCClusterRoleBindings returns the ClusterRoleBindings of the ClusterRoles which use SecurityContextConstraints
+func (c *IBMBlockCSI) GenerateSCCClusterRoleBindings() []*rbacv1.ClusterRoleBinding {
+	return []*rbacv1.ClusterRoleBinding{
+		c.GenerateSCCForControllerClusterRoleBinding(),
+		c.GenerateSCCForNodeClusterRoleBinding(),
+	}
 }
 
 func (c *IBMBlockCSI) GenerateControllerServiceAccount() *corev1.ServiceAccount {
@@ -89,19 +175,19 @@ func (c *IBMBlockCSI) GenerateNodeServiceAccount() *corev1.ServiceAccount {
 }
 
 func getServiceAccount(c *IBMBlockCSI, serviceAccountResourceName config.ResourceName) *corev1.ServiceAccount {
-	secrets := getImagePullSecrets(c.Spec.ImagePullSecrets)
-	return &corev1.ServiceAccount{
+	serviceAccount := &corev1.ServiceAccount{
 		ObjectMeta: metav1.ObjectMeta{
 			Name:      config.GetNameForResource(serviceAccountResourceName, c.Name),
 			Namespace: c.Namespace,
 			Labels:    c.GetLabels(),
 		},
-		ImagePullSecrets: secrets,
 	}
+	common.SetServiceAccountImagePullSecrets(serviceAccount, c.GetImagePullSecrets())
+	return serviceAccount
 }
 
 func (c *IBMBlockCSI) GenerateExternalProvisionerClusterRole() *rbacv1.ClusterRole {
-	return &rbacv1.ClusterRole{
+	clusterRole := &rbacv1.ClusterRole{
 		ObjectMeta: metav1.ObjectMeta{
 			Name: config.GetNameForResource(config.ExternalProvisionerClusterRole, c.Name),
 		},
@@ -158,6 +244,26 @@ func (c *IBMBlockCSI) GenerateExternalProvisionerClusterRole() *rbacv1.ClusterRo
 			},
 		},
 	}
+	if c.IsCapacityTrackingEnabled() {
+		clusterRole.Rules = append(clusterRole.Rules,
+			rbacv1.PolicyRule{
+				APIGroups: []string{storageApiGroup},
+				Resources: []string{csiStorageCapacitiesResource},
+				Verbs:     []string{verbGet, verbList, verbWatch, verbCreate, verbUpdate, verbPatch, verbDelete},
+			},
+			rbacv1.PolicyRule{
+				APIGroups: []string{""},
+				Resources: []string{podsResource},
+				Verbs:     []string{verbGet},
+			},
+			rbacv1.PolicyRule{
+				APIGroups: []string{appsApiGroup},
+				Resources: []string{statefulSetsResource, replicaSetsResource},
+				Verbs:     []string{verbGet},
+			},
+		)
+	}
+	return clusterRole
 }
 
 func (c *IBMBlockCSI) GenerateExternalProvisionerClusterRoleBinding() *rbacv1.ClusterRoleBinding {
@@ -236,7 +342,7 @@ func (c *IBMBlockCSI) GenerateExternalAttacherClusterRoleBinding() *rbacv1.Clust
 }
 
 func (c *IBMBlockCSI) GenerateExternalSnapshotterClusterRole() *rbacv1.ClusterRole {
-	return &rbacv1.ClusterRole{
+	clusterRole := &rbacv1.ClusterRole{
 		ObjectMeta: metav1.ObjectMeta{
 			Name: config.GetNameForResource(config.ExternalSnapshotterClusterRole, c.Name),
 		},
@@ -268,6 +374,26 @@ func (c *IBMBlockCSI) GenerateExternalSnapshotterClusterRole() *rbacv1.ClusterRo
 			},
 		},
 	}
+	if c.IsVolumeGroupSnapshotsEnabled() {
+		clusterRole.Rules = append(clusterRole.Rules,
+			rbacv1.PolicyRule{
+				APIGroups: []string{groupSnapshotStorageApiGroup},
+				Resources: []string{volumeGroupSnapshotClassesResource},
+				Verbs:     []string{verbGet, verbList, verbWatch},
+			},
+			rbacv1.PolicyRule{
+				APIGroups: []string{groupSnapshotStorageApiGroup},
+				Resources: []string{volumeGroupSnapshotContentsResource},
+				Verbs:     []string{verbGet, verbList, verbWatch, verbUpdate, verbPatch},
+			},
+			rbacv1.PolicyRule{
+				APIGroups: []string{groupSnapshotStorageApiGroup},
+				Resources: []string{volumeGroupSnapshotContentsStatusResource},
+				Verbs:     []string{verbUpdate, verbPatch},
+			},
+		)
+	}
+	return clusterRole
 }
 
 func (c *IBMBlockCSI) GenerateExternalSnapshotterClusterRoleBinding() *rbacv1.ClusterRoleBinding {
@@ -350,6 +476,61 @@ func (c *IBMBlockCSI) GenerateExternalResizerClusterRoleBinding() *rbacv1.Cluste
 	}
 }
 
+func (c *IBMBlockCSI) GenerateExternalHealthMonitorClusterRole() *rbacv1.ClusterRole {
+	return &rbacv1.ClusterRole{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: config.GetNameForResource(config.ExternalHealthMonitorClusterRole, c.Name),
+		},
+		Rules: []rbacv1.PolicyRule{
+			{
+				APIGroups: []string{""},
+				Resources: []string{persistentVolumesResource},
+				Verbs:     []string{verbGet, verbList, verbWatch},
+			},
+			{
+				APIGroups: []string{""},
+				Resources: []string{persistentVolumeClaimsResource},
+				Verbs:     []string{verbGet, verbList, verbWatch},
+			},
+			{
+				APIGroups: []string{""},
+				Resources: []string{nodesResource},
+				Verbs:     []string{verbGet, verbList, verbWatch},
+			},
+			{
+				APIGroups: []string{""},
+				Resources: []string{podsResource},
+				Verbs:     []string{verbGet, verbList, verbWatch},
+			},
+			{
+				APIGroups: []string{""},
+				Resources: []string{eventsResource},
+				Verbs:     []string{verbGet, verbList, verbWatch, verbCreate, verbPatch},
+			},
+		},
+	}
+}
+
+func (c *IBMBlockCSI) GenerateExternalHealthMonitorClusterRoleBinding() *rbacv1.ClusterRoleBinding {
+	return &rbacv1.ClusterRoleBinding{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: config.GetNameForResource(config.ExternalHealthMonitorClusterRoleBinding, c.Name),
+		},
+		Subjects: []rbacv1.Subject{
+			{
+				Kind:      "ServiceAccount",
+				Name:      config.GetNameForResource(config.CSIControllerServiceAccount, c.Name),
+				Namespace: c.Namespace,
+			},
+		},
+		RoleRef: rbacv1.RoleRef{
+			Kind:     "ClusterRole",
+			Name:     config.GetNameForResource(config.ExternalHealthMonitorClusterRole, c.Name),
+			APIGroup: rbacAuthorizationApiGroup,
+		},
+	}
+}
+
 func (c *IBMBlockCSI) GenerateCSIAddonsReplicatorClusterRole() *rbacv1.ClusterRole {
 	return &rbacv1.ClusterRole{
 		ObjectMeta: metav1.ObjectMeta{
@@ -530,7 +711,7 @@ func (c *IBMBlockCSI) GenerateSCCForNodeClusterRole() *rbacv1.ClusterRole {
 			{
 				APIGroups:     []string{securityOpenshiftApiGroup},
 				Resources:     []string{securityContextConstraintsResource},
-				ResourceNames: []string{"privileged"},
+				ResourceNames: []string{c.GetCSINodeSCCName()},
 				Verbs:         []string{"use"},
 			},
 			{
@@ -0,0 +1,40 @@
+/**
+ * Copyright 2025 IBM Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package crutils
+
+import (
+	corev1 "k8s.io/api/core/v1"
+
+	"github.com/IBM/ibm-block-csi-operator/controllers/internal/common"
+	"github.com/IBM/ibm-block-csi-operator/pkg/config"
+)
+
+// GetTrustedCAConfigMapName returns the name of the ConfigMap of the CA bundle the controller and the node plugins
+// trust, empty if there is none
+func (c *IBMBlockCSI) GetTrustedCAConfigMapName() string {
+	return common.GetTrustedCAConfigMapName(c.Spec.TrustedCA,
+		config.GetNameForResource(config.TrustedCABundleConfigMap, c.Name))
+}
+
+// GenerateTrustedCABundleConfigMap returns the ConfigMap OpenShift injects the trusted CA bundle of the cluster into,
+// nil if the cluster bundle is not injected
+func (c *IBMBlockCSI) GenerateTrustedCABundleConfigMap() *corev1.ConfigMap {
+	if c.Spec.TrustedCA == nil || !c.Spec.TrustedCA.InjectClusterBundle {
+		return nil
+	}
+	return common.GenerateTrustedCABundleConfigMap(c.GetTrustedCAConfigMapName(), c.Namespace, c.GetLabels())
+}
@@ -0,0 +1,59 @@
+/**
+ * Copyright 2025 IBM Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package crutils_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	csiv1 "github.com/IBM/ibm-block-csi-operator/api/v1"
+	. "github.com/IBM/ibm-block-csi-operator/controllers/internal/crutils"
+	"github.com/IBM/ibm-block-csi-operator/pkg/config"
+)
+
+var _ = Describe("TrustedCA", func() {
+	var ibcWrapper *IBMBlockCSI
+
+	BeforeEach(func() {
+		ibcWrapper = New(&csiv1.IBMBlockCSI{ObjectMeta: metav1.ObjectMeta{Name: "ibm-block-csi", Namespace: "default"}}, "1.28")
+	})
+
+	It("should not trust a CA bundle by default", func() {
+		Expect(ibcWrapper.GetTrustedCAConfigMapName()).To(BeEmpty())
+		Expect(ibcWrapper.GenerateTrustedCABundleConfigMap()).To(BeNil())
+	})
+
+	It("should use the given ConfigMap", func() {
+		ibcWrapper.Spec.TrustedCA = &csiv1.TrustedCA{ConfigMap: "my-ca"}
+		Expect(ibcWrapper.GetTrustedCAConfigMapName()).To(Equal("my-ca"))
+		Expect(ibcWrapper.GenerateTrustedCABundleConfigMap()).To(BeNil())
+	})
+
+	It("should generate the ConfigMap the cluster bundle is injected into", func() {
+		ibcWrapper.Spec.TrustedCA = &csiv1.TrustedCA{InjectClusterBundle: true}
+		configMap := ibcWrapper.GenerateTrustedCABundleConfigMap()
+		Expect(configMap.Name).To(Equal("ibm-block-csi-trusted-ca-bundle"))
+		Expect(configMap.Labels).To(HaveKeyWithValue(config.InjectTrustedCABundleLabel, "true"))
+		Expect(ibcWrapper.GetTrustedCAConfigMapName()).To(Equal(configMap.Name))
+	})
+
+	It("should refuse both a ConfigMap and the cluster bundle", func() {
+		ibcWrapper.Spec.TrustedCA = &csiv1.TrustedCA{ConfigMap: "my-ca", InjectClusterBundle: true}
+		Expect(ibcWrapper.Validate()).To(MatchError(ContainSubstring("trustedCA")))
+	})
+})
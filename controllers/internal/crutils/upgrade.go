@@ -0,0 +1,162 @@
+/**
+ * Copyright 2025 IBM Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package crutils
+
+import (
+	"fmt"
+	"strconv"
+
+	appsv1 "k8s.io/api/apps/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	utilversion "k8s.io/apimachinery/pkg/util/version"
+
+	csiv1 "github.com/IBM/ibm-block-csi-operator/api/v1"
+	"github.com/IBM/ibm-block-csi-operator/controllers/internal/common"
+	"github.com/IBM/ibm-block-csi-operator/pkg/config"
+	oversion "github.com/IBM/ibm-block-csi-operator/version"
+)
+
+// maxDriverMinorSkew is how many minor versions the driver may be older than the driver of the operator
+const maxDriverMinorSkew = 1
+
+// IsVersionForced returns true if the CR has the force version annotation
+func (c *IBMBlockCSI) IsVersionForced() bool {
+	forced, err := strconv.ParseBool(c.Annotations[config.ForceVersionAnnotation])
+	return err == nil && forced
+}
+
+// ValidateVersions checks the desired controller and node versions against the supported version skew
+// and refuses a downgrade across minor versions of what runs, unless the version is forced.
+// Versions which are not semantic, e.g. a digest or latest, are not checked.
+func (c *IBMBlockCSI) ValidateVersions() error {
+	if c.IsVersionForced() {
+		return nil
+	}
+
+	operatorVersion := utilversion.MustParseGeneric(oversion.DriverVersion)
+	components := []struct {
+		name           string
+		container      string
+		image          string
+		removed        bool
+		desiredVersion *utilversion.Version
+	}{
+		{name: "controller", container: config.CSIControllerContainerName, image: c.GetCSIControllerImage(),
+			removed: c.GetControllerManagementState() == csiv1.ManagementStateRemoved},
+		{name: "node", container: config.CSINodeContainerName, image: c.GetCSINodeImage(),
+			removed: c.GetNodeManagementState() == csiv1.ManagementStateRemoved},
+	}
+
+	for i := range components {
+		component := &components[i]
+		if component.removed {
+			continue
+		}
+		desiredVersion, err := utilversion.ParseGeneric(common.GetImageVersion(component.image))
+		if err != nil {
+			continue
+		}
+		component.desiredVersion = desiredVersion
+
+		if desiredVersion.Major() != operatorVersion.Major() || desiredVersion.Minor() > operatorVersion.Minor() ||
+			desiredVersion.Minor()+maxDriverMinorSkew < operatorVersion.Minor() {
+			return fmt.Errorf("%s version %s is not supported by the operator of driver version %s, "+
+				"set the %s annotation to force it", component.name, desiredVersion, operatorVersion,
+				config.ForceVersionAnnotation)
+		}
+
+		for _, runningVersion := range common.GetContainerVersions(c.Status.ComponentVersions, component.container) {
+			running, err := utilversion.ParseGeneric(runningVersion)
+			if err != nil {
+				continue
+			}
+			if desiredVersion.Major() < running.Major() ||
+				(desiredVersion.Major() == running.Major() && desiredVersion.Minor() < running.Minor()) {
+				return fmt.Errorf("downgrade of the %s from version %s to %s is not allowed, "+
+					"set the %s annotation to force it", component.name, running, desiredVersion,
+					config.ForceVersionAnnotation)
+			}
+		}
+	}
+
+	controllerVersion, nodeVersion := components[0].desiredVersion, components[1].desiredVersion
+	if controllerVersion != nil && nodeVersion != nil &&
+		(controllerVersion.Major() != nodeVersion.Major() || controllerVersion.Minor() != nodeVersion.Minor()) {
+		return fmt.Errorf("controller version %s and node version %s must have the same minor version, "+
+			"set the %s annotation to force it", controllerVersion, nodeVersion, config.ForceVersionAnnotation)
+	}
+	return nil
+}
+
+// SetDegradedCondition sets the Degraded condition from the error the spec was refused with, nil if it was not
+func (c *IBMBlockCSI) SetDegradedCondition(specErr error) {
+	condition := metav1.Condition{
+		Type:               csiv1.ConditionDegraded,
+		ObservedGeneration: c.Generation,
+		Status:             metav1.ConditionFalse,
+		Reason:             "AsExpected",
+	}
+	if specErr != nil {
+		condition.Status = metav1.ConditionTrue
+		condition.Reason = "InvalidSpec"
+		condition.Message = specErr.Error()
+	}
+	meta.SetStatusCondition(&c.Status.Conditions, condition)
+}
+
+// SetUpgradeableCondition sets the Upgradeable condition, the operator is not upgradeable while
+// the CR is degraded or a rollout of the controller or the node is in progress.
+// A nil workload is not deployed.
+func (c *IBMBlockCSI) SetUpgradeableCondition(controller *appsv1.StatefulSet, node *appsv1.DaemonSet) {
+	condition := metav1.Condition{
+		Type:               csiv1.ConditionUpgradeable,
+		ObservedGeneration: c.Generation,
+		Status:             metav1.ConditionTrue,
+		Reason:             "AsExpected",
+	}
+	switch {
+	case meta.IsStatusConditionTrue(c.Status.Conditions, csiv1.ConditionDegraded):
+		condition.Status = metav1.ConditionFalse
+		condition.Reason = "Degraded"
+		condition.Message = "the IBMBlockCSI is degraded"
+	case controller != nil && isStatefulSetRollingOut(controller):
+		condition.Status = metav1.ConditionFalse
+		condition.Reason = "RolloutInProgress"
+		condition.Message = "the controller StatefulSet rollout is in progress"
+	case node != nil && isDaemonSetRollingOut(node):
+		condition.Status = metav1.ConditionFalse
+		condition.Reason = "RolloutInProgress"
+		condition.Message = "the node DaemonSet rollout is in progress"
+	}
+	meta.SetStatusCondition(&c.Status.Conditions, condition)
+}
+
+func isStatefulSetRollingOut(statefulSet *appsv1.StatefulSet) bool {
+	replicas := int32(1)
+	if statefulSet.Spec.Replicas != nil {
+		replicas = *statefulSet.Spec.Replicas
+	}
+	return statefulSet.Status.ObservedGeneration < statefulSet.Generation ||
+		statefulSet.Status.UpdatedReplicas < replicas
+}
+
+func isDaemonSetRollingOut(daemonSet *appsv1.DaemonSet) bool {
+	return daemonSet.Status.ObservedGeneration < daemonSet.Generation ||
+		daemonSet.Status.UpdatedNumberScheduled < daemonSet.Status.DesiredNumberScheduled ||
+		daemonSet.Status.NumberAvailable < daemonSet.Status.DesiredNumberScheduled
+}
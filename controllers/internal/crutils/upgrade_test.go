@@ -0,0 +1,116 @@
+/**
+ * Copyright 2025 IBM Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package crutils_test
+
+import (
+	"fmt"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	appsv1 "k8s.io/api/apps/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	utilversion "k8s.io/apimachinery/pkg/util/version"
+
+	csiv1 "github.com/IBM/ibm-block-csi-operator/api/v1"
+	. "github.com/IBM/ibm-block-csi-operator/controllers/internal/crutils"
+	"github.com/IBM/ibm-block-csi-operator/pkg/config"
+	oversion "github.com/IBM/ibm-block-csi-operator/version"
+)
+
+var _ = Describe("Upgrade guardrails", func() {
+	var ibc *csiv1.IBMBlockCSI
+	var ibcWrapper *IBMBlockCSI
+	operatorVersion := utilversion.MustParseGeneric(oversion.DriverVersion)
+	previousMinor := fmt.Sprintf("%d.%d.0", operatorVersion.Major(), operatorVersion.Minor()-1)
+	nextMinor := fmt.Sprintf("%d.%d.0", operatorVersion.Major(), operatorVersion.Minor()+1)
+
+	BeforeEach(func() {
+		ibc = &csiv1.IBMBlockCSI{
+			Spec: csiv1.IBMBlockCSISpec{
+				Controller: csiv1.IBMBlockCSIControllerSpec{Repository: "registry/controller", Tag: oversion.DriverVersion},
+				Node:       csiv1.IBMBlockCSINodeSpec{Repository: "registry/node", Tag: oversion.DriverVersion},
+			},
+		}
+		ibcWrapper = New(ibc, "1.13")
+	})
+
+	Context("ValidateVersions", func() {
+		It("should accept the driver version of the operator and a digest", func() {
+			Expect(ibcWrapper.ValidateVersions()).To(Succeed())
+			ibc.Spec.Node.Tag = ""
+			ibc.Spec.Node.Repository = "registry/node@sha256:0123"
+			Expect(ibcWrapper.ValidateVersions()).To(Succeed())
+		})
+
+		It("should accept the previous minor version", func() {
+			ibc.Spec.Controller.Tag = previousMinor
+			ibc.Spec.Node.Tag = previousMinor
+			Expect(ibcWrapper.ValidateVersions()).To(Succeed())
+		})
+
+		It("should refuse a version newer than the operator", func() {
+			ibc.Spec.Controller.Tag = nextMinor
+			ibc.Spec.Node.Tag = nextMinor
+			Expect(ibcWrapper.ValidateVersions()).To(MatchError(ContainSubstring("is not supported")))
+		})
+
+		It("should refuse a controller and a node of different minor versions", func() {
+			ibc.Spec.Node.Tag = previousMinor
+			Expect(ibcWrapper.ValidateVersions()).To(MatchError(ContainSubstring("must have the same minor version")))
+		})
+
+		It("should refuse a downgrade across minor versions unless forced", func() {
+			ibc.Status.ComponentVersions = []csiv1.ComponentVersion{
+				{Container: config.CSINodeContainerName, Version: oversion.DriverVersion},
+			}
+			ibc.Spec.Controller.Tag = previousMinor
+			ibc.Spec.Node.Tag = previousMinor
+			Expect(ibcWrapper.ValidateVersions()).To(MatchError(ContainSubstring("downgrade of the node")))
+
+			ibc.Annotations = map[string]string{config.ForceVersionAnnotation: "true"}
+			Expect(ibcWrapper.ValidateVersions()).To(Succeed())
+		})
+	})
+
+	Context("SetUpgradeableCondition", func() {
+		isUpgradeable := func() bool {
+			return meta.IsStatusConditionTrue(ibc.Status.Conditions, csiv1.ConditionUpgradeable)
+		}
+
+		It("should not be upgradeable while the node DaemonSet rolls out", func() {
+			node := &appsv1.DaemonSet{Status: appsv1.DaemonSetStatus{
+				DesiredNumberScheduled: 3, UpdatedNumberScheduled: 2, NumberAvailable: 3}}
+			ibcWrapper.SetUpgradeableCondition(nil, node)
+			Expect(isUpgradeable()).To(BeFalse())
+
+			node.Status.UpdatedNumberScheduled = 3
+			ibcWrapper.SetUpgradeableCondition(nil, node)
+			Expect(isUpgradeable()).To(BeTrue())
+		})
+
+		It("should not be upgradeable while degraded", func() {
+			ibcWrapper.SetDegradedCondition(fmt.Errorf("invalid"))
+			ibcWrapper.SetUpgradeableCondition(nil, nil)
+			Expect(meta.FindStatusCondition(ibc.Status.Conditions, csiv1.ConditionUpgradeable).Reason).To(Equal("Degraded"))
+
+			ibcWrapper.SetDegradedCondition(nil)
+			ibcWrapper.SetUpgradeableCondition(nil, nil)
+			Expect(isUpgradeable()).To(BeTrue())
+			Expect(meta.IsStatusConditionFalse(ibc.Status.Conditions, csiv1.ConditionDegraded)).To(BeTrue())
+		})
+	})
+})
@@ -16,9 +16,36 @@
 
 package crutils
 
+import (
+	"fmt"
+
+	"github.com/IBM/ibm-block-csi-operator/controllers/internal/common"
+)
+
 // Validate checks if the spec is valid
 // Replace it with kubernetes native default setter when it is available.
 // https://kubernetes.io/docs/tasks/access-kubernetes-api/custom-resources/custom-resource-definitions/#validation
 func (c *IBMBlockCSI) Validate() error {
-	return nil
+	if err := common.ValidateOverrides(c.Spec.Controller.Overrides); err != nil {
+		return fmt.Errorf("controller %v", err)
+	}
+	if err := common.ValidateOverrides(c.Spec.Node.Overrides); err != nil {
+		return fmt.Errorf("node %v", err)
+	}
+	if err := c.ValidateCSIDriver(); err != nil {
+		return err
+	}
+	if err := c.ValidateCallHome(); err != nil {
+		return err
+	}
+	if err := common.ValidateTrustedCA(c.Spec.TrustedCA); err != nil {
+		return err
+	}
+	if err := common.ValidateNetworkPolicy(c.Spec.NetworkPolicy); err != nil {
+		return err
+	}
+	if err := common.ValidateImagePullSecretSources(c.Spec.ImagePullSecretSources, c.Namespace); err != nil {
+		return err
+	}
+	return c.ValidateVersions()
 }
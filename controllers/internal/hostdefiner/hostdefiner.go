@@ -18,16 +18,37 @@ package hostdefiner
 
 import (
 	"fmt"
+	"sort"
 
 	csiv1 "github.com/IBM/ibm-block-csi-operator/api/v1"
 	"github.com/IBM/ibm-block-csi-operator/controllers/internal/common"
 	"github.com/IBM/ibm-block-csi-operator/pkg/config"
 	csiversion "github.com/IBM/ibm-block-csi-operator/version"
+	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
 	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
 )
 
 type HostDefiner struct {
 	*csiv1.HostDefiner
+	// ClusterProxy is the cluster-wide proxy, from the environment of the operator or the Proxy of OpenShift,
+	// nil if the cluster has none
+	ClusterProxy *csiv1.ProxyConfig
+	// TrustedCABundleChecksum is the checksum of the trusted CA bundle, the pods roll when it changes
+	TrustedCABundleChecksum string
+	// ArraySecretCIDRs are the CIDRs of the management addresses in the Secrets of the storage classes of the driver
+	ArraySecretCIDRs []string
+
+	// APIServerDestination is the egress destination of the endpoints of the Kubernetes API
+	APIServerDestination common.NetworkPolicyDestination
+	// ArraySecretsChecksum is the checksum of the versions of the labelled array Secrets which HostDefinitions
+	// or StorageClasses of the driver reference, the pods roll when a Secret is rotated
+	ArraySecretsChecksum string
+	// NodeConnectivityPolicies are the NodeConnectivityPolicies which apply to the nodes, by node name,
+	// nil for the nodes no policy selects
+	NodeConnectivityPolicies map[string]*csiv1.NodeConnectivityPolicy
 }
 
 func New(hd *csiv1.HostDefiner) *HostDefiner {
@@ -94,3 +115,109 @@ func (hd *HostDefiner) GetHostDefinerImage() string {
 	}
 	return hd.Spec.HostDefiner.Repository + ":" + hd.Spec.HostDefiner.Tag
 }
+
+func (hd *HostDefiner) IsReconcilePaused() bool {
+	return common.IsReconcilePaused(hd.Annotations)
+}
+
+func (hd *HostDefiner) GetHostDefinerManagementState() csiv1.ManagementState {
+	return common.GetManagementState(hd.Spec.HostDefiner.ManagementState)
+}
+
+// GetHostDefinerOverridesHash returns the hash of the host definer pod template overrides
+func (hd *HostDefiner) GetHostDefinerOverridesHash() string {
+	return common.GetOverridesHash(hd.Spec.HostDefiner.Overrides)
+}
+
+// SetComponentVersions sets the versions the host definer pods run
+func (hd *HostDefiner) SetComponentVersions(pods []corev1.Pod) {
+	hd.Status.ComponentVersions = common.GetComponentVersions(config.HostDefiner.String(), pods)
+
+	hd.Status.Version = ""
+	versions := common.GetContainerVersions(hd.Status.ComponentVersions, config.HostDefinerContainerName)
+	if len(versions) == 1 {
+		hd.Status.Version = versions[0]
+	}
+}
+
+// Validate checks if the spec is valid
+func (hd *HostDefiner) Validate() error {
+	if err := common.ValidateTrustedCA(hd.Spec.TrustedCA); err != nil {
+		return err
+	}
+	if err := common.ValidateNetworkPolicy(hd.Spec.NetworkPolicy); err != nil {
+		return err
+	}
+	if err := common.ValidateImagePullSecretSources(hd.Spec.ImagePullSecretSources, hd.Namespace); err != nil {
+		return err
+	}
+	return common.ValidateIOGroupPlacement(hd.Spec.IOGroupPlacement)
+}
+
+// GetTrustedCAConfigMapName returns the name of the ConfigMap of the CA bundle the host definer trusts,
+// empty if there is none
+func (hd *HostDefiner) GetTrustedCAConfigMapName() string {
+	return common.GetTrustedCAConfigMapName(hd.Spec.TrustedCA,
+		config.GetNameForResource(config.HostDefinerTrustedCABundleConfigMap, hd.Name))
+}
+
+// GenerateTrustedCABundleConfigMap returns the ConfigMap OpenShift injects the trusted CA bundle of the cluster into,
+// nil if the cluster bundle is not injected
+func (hd *HostDefiner) GenerateTrustedCABundleConfigMap() *corev1.ConfigMap {
+	if hd.Spec.TrustedCA == nil || !hd.Spec.TrustedCA.InjectClusterBundle {
+		return nil
+	}
+	return common.GenerateTrustedCABundleConfigMap(hd.GetTrustedCAConfigMapName(), hd.Namespace, hd.GetLabels())
+}
+
+// IsNetworkPolicyEnabled returns true if a NetworkPolicy is generated for the host definer pods
+func (hd *HostDefiner) IsNetworkPolicyEnabled() bool {
+	return common.IsNetworkPolicyEnabled(hd.Spec.NetworkPolicy)
+}
+
+// GenerateNetworkPolicy returns the NetworkPolicy of the host definer pods, which allows the ingress
+// to the metrics ports only, and the egress through the cluster-wide proxy, nil if it is not enabled
+func (hd *HostDefiner) GenerateNetworkPolicy() *networkingv1.NetworkPolicy {
+	if !hd.IsNetworkPolicyEnabled() {
+		return nil
+	}
+	return common.GenerateNetworkPolicy(config.GetNameForResource(config.HostDefinerNetworkPolicy, hd.Name),
+		hd.Namespace, hd.GetLabels(), hd.GetHostDefinerSelectorLabels(), hd.Spec.NetworkPolicy.MetricsPorts,
+		common.GetNetworkPolicyDestinations(hd.APIServerDestination, hd.ClusterProxy,
+			common.MergeArrayCIDRs(hd.Spec.NetworkPolicy, hd.ArraySecretCIDRs)))
+}
+
+// GetImagePullSecrets returns the pull secrets attached to the ServiceAccount of the host definer
+func (hd *HostDefiner) GetImagePullSecrets() []string {
+	return common.GetImagePullSecrets(hd.Spec.ImagePullSecrets, hd.Spec.ImagePullSecretSources, hd.Name)
+}
+
+// GenerateImagePullSecretCopy returns the copy of the pull secret of a source in the namespace of the HostDefiner
+func (hd *HostDefiner) GenerateImagePullSecretCopy(source csiv1.ImagePullSecretSource,
+	sourceSecret *corev1.Secret) *corev1.Secret {
+	return common.GenerateImagePullSecretCopy(sourceSecret,
+		common.GetImagePullSecretCopyName(source, hd.Name), hd.Namespace, hd.GetLabels())
+}
+
+// SetArraySecrets sets the invalid array Secrets of the status and the checksum of the labelled array Secrets
+// which are referenced
+func (hd *HostDefiner) SetArraySecrets(secrets []corev1.Secret, referenced sets.Set[types.NamespacedName]) {
+	var invalidSecrets []csiv1.InvalidArraySecret
+	for i := range secrets {
+		if err := common.ValidateArraySecret(&secrets[i]); err != nil {
+			invalidSecrets = append(invalidSecrets, csiv1.InvalidArraySecret{
+				Name:      secrets[i].Name,
+				Namespace: secrets[i].Namespace,
+				Message:   err.Error(),
+			})
+		}
+	}
+	sort.Slice(invalidSecrets, func(i, j int) bool {
+		if invalidSecrets[i].Namespace != invalidSecrets[j].Namespace {
+			return invalidSecrets[i].Namespace < invalidSecrets[j].Namespace
+		}
+		return invalidSecrets[i].Name < invalidSecrets[j].Name
+	})
+	hd.Status.InvalidArraySecrets = invalidSecrets
+	hd.ArraySecretsChecksum = common.GetArraySecretsChecksum(secrets, referenced)
+}
@@ -17,12 +17,27 @@
 package hostdefiner
 
 import (
+	"github.com/IBM/ibm-block-csi-operator/controllers/internal/common"
 	"github.com/IBM/ibm-block-csi-operator/pkg/config"
 	corev1 "k8s.io/api/core/v1"
 	rbacv1 "k8s.io/api/rbac/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
+// GenerateClusterRoles returns all the ClusterRoles of the host definer
+func (c *HostDefiner) GenerateClusterRoles() []*rbacv1.ClusterRole {
+	return []*rbacv1.ClusterRole{
+		c.GenerateHostDefinerClusterRole(),
+	}
+}
+
+// GenerateClusterRoleBindings returns all the ClusterRoleBindings of the host definer
+func (c *HostDefiner) GenerateClusterRoleBindings() []*rbacv1.ClusterRoleBinding {
+	return []*rbacv1.ClusterRoleBinding{
+		c.GenerateHostDefinerClusterRoleBinding(),
+	}
+}
+
 func (c *HostDefiner) GenerateHostDefinerClusterRoleBinding() *rbacv1.ClusterRoleBinding {
 	return &rbacv1.ClusterRoleBinding{
 		ObjectMeta: metav1.ObjectMeta{
@@ -104,19 +119,13 @@ func (c *HostDefiner) GenerateHostDefinerClusterRole() *rbacv1.ClusterRole {
 }
 
 func (c *HostDefiner) GenerateServiceAccount() *corev1.ServiceAccount {
-	secrets := []corev1.LocalObjectReference{}
-	if len(c.Spec.ImagePullSecrets) > 0 {
-		for _, s := range c.Spec.ImagePullSecrets {
-			secrets = append(secrets, corev1.LocalObjectReference{Name: s})
-		}
-	}
-
-	return &corev1.ServiceAccount{
+	serviceAccount := &corev1.ServiceAccount{
 		ObjectMeta: metav1.ObjectMeta{
 			Name:      config.GetNameForResource(config.HostDefinerServiceAccount, c.Name),
 			Namespace: c.Namespace,
 			Labels:    c.GetLabels(),
 		},
-		ImagePullSecrets: secrets,
 	}
+	common.SetServiceAccountImagePullSecrets(serviceAccount, c.GetImagePullSecrets())
+	return serviceAccount
 }
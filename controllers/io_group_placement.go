@@ -0,0 +1,116 @@
+/**
+ * Copyright 2025 IBM Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package controllers
+
+import (
+	"context"
+	"sort"
+
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/IBM/ibm-block-csi-operator/controllers/internal/common"
+	"github.com/IBM/ibm-block-csi-operator/controllers/internal/hostdefiner"
+)
+
+// reconcileIOGroupPlacement labels the nodes with the IO groups their hosts are placed in, before the host definer
+// defines the hosts, and sets the resulting number of nodes per IO group in the status. The IO groups of the
+// NodeConnectivityPolicy of a node take precedence over the placement, and their labels are removed when the
+// policy stops applying to the node. The placed nodes and the nodes labelled by others are never moved, and
+// the placement applies to the nodes with no IO group label
+func (r *HostDefinerReconciler) reconcileIOGroupPlacement(instance *hostdefiner.HostDefiner) error {
+	logger := hostDefinerLog.WithValues("Resource Type", "Node")
+
+	nodes := &corev1.NodeList{}
+	if err := r.List(context.TODO(), nodes); err != nil {
+		return err
+	}
+	nodesPerIOGroup := map[int]int32{}
+	for _, ioGroupNodes := range common.GetIOGroupDistribution(nodes.Items) {
+		nodesPerIOGroup[ioGroupNodes.IOGroup] = ioGroupNodes.Nodes
+	}
+
+	sort.Slice(nodes.Items, func(i, j int) bool {
+		return nodes.Items[i].Name < nodes.Items[j].Name
+	})
+	for i := range nodes.Items {
+		node := &nodes.Items[i]
+		patch := client.MergeFrom(node.DeepCopy())
+		changed := false
+		if policy := instance.NodeConnectivityPolicies[node.Name]; policy != nil && len(policy.Spec.IOGroups) > 0 {
+			changed = common.SetNodeIOGroups(node, policy.Spec.IOGroups, policy.Name)
+		} else {
+			if common.IsNodePlacedByPolicy(node) {
+				changed = removeNodeIOGroups(node, nodesPerIOGroup)
+			}
+			if len(common.GetNodeIOGroups(node)) == 0 {
+				ioGroups := common.GetPlacementIOGroups(instance.Spec.IOGroupPlacement, node, nodesPerIOGroup)
+				for _, ioGroup := range ioGroups {
+					nodesPerIOGroup[ioGroup]++
+				}
+				changed = common.SetNodeIOGroups(node, ioGroups, "") || changed
+			}
+		}
+		if !changed {
+			continue
+		}
+		logger.Info("labelling node with the IO groups of its hosts", "Name", node.Name,
+			"IOGroups", common.GetNodeIOGroups(node))
+		if err := r.Patch(context.TODO(), node, patch); err != nil {
+			return err
+		}
+	}
+	instance.Status.IOGroupDistribution = common.GetIOGroupDistribution(nodes.Items)
+	return nil
+}
+
+// removeNodeIOGroups removes the IO group labels the operator set on a node, and updates the number of nodes
+// per IO group. It returns true if the node changed
+func removeNodeIOGroups(node *corev1.Node, nodesPerIOGroup map[int]int32) bool {
+	for _, ioGroup := range common.GetNodeIOGroups(node) {
+		nodesPerIOGroup[ioGroup]--
+	}
+	changed := common.SetNodeIOGroups(node, nil, "")
+	for _, ioGroup := range common.GetNodeIOGroups(node) {
+		nodesPerIOGroup[ioGroup]++
+	}
+	return changed
+}
+
+// unlabelNodes removes the IO group labels and the NodeConnectivityPolicy values the operator set on the nodes,
+// when the HostDefiner is deleted
+func (r *HostDefinerReconciler) unlabelNodes() error {
+	logger := hostDefinerLog.WithValues("Resource Type", "Node")
+
+	nodes := &corev1.NodeList{}
+	if err := r.List(context.TODO(), nodes); err != nil {
+		return err
+	}
+	for i := range nodes.Items {
+		node := &nodes.Items[i]
+		patch := client.MergeFrom(node.DeepCopy())
+		changed := common.SetNodeIOGroups(node, nil, "")
+		if !common.SetNodeConnectivityPolicy(node, nil) && !changed {
+			continue
+		}
+		logger.Info("removing the labels of the host definer from node", "Name", node.Name)
+		if err := r.Patch(context.TODO(), node, patch); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,152 @@
+/**
+ * Copyright 2025 IBM Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package controllers
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	csiv1 "github.com/IBM/ibm-block-csi-operator/api/v1"
+	"github.com/IBM/ibm-block-csi-operator/controllers/internal/hostdefiner"
+	oconfig "github.com/IBM/ibm-block-csi-operator/pkg/config"
+)
+
+var _ = Describe("IOGroupPlacement", func() {
+	var reconciler *HostDefinerReconciler
+	var instance *hostdefiner.HostDefiner
+
+	newNode := func(name string, labels map[string]string) *corev1.Node {
+		return &corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: name, Labels: labels}}
+	}
+	getNodeLabels := func(name string) map[string]string {
+		node := &corev1.Node{}
+		Expect(reconciler.Get(context.TODO(), client.ObjectKey{Name: name}, node)).To(Succeed())
+		return node.Labels
+	}
+	ioGroupLabel := func(ioGroup string) string {
+		return oconfig.HostDefinerIOGroupLabelPrefix + ioGroup
+	}
+
+	BeforeEach(func() {
+		scheme := runtime.NewScheme()
+		Expect(clientgoscheme.AddToScheme(scheme)).To(Succeed())
+		Expect(csiv1.AddToScheme(scheme)).To(Succeed())
+		policy := &csiv1.NodeConnectivityPolicy{
+			ObjectMeta: metav1.ObjectMeta{Name: "bare-metal"},
+			Spec: csiv1.NodeConnectivityPolicySpec{
+				NodeSelector: metav1.LabelSelector{MatchLabels: map[string]string{"pool": "bare-metal"}},
+				IOGroups:     []int{3},
+			},
+		}
+		reconciler = &HostDefinerReconciler{
+			Client: fake.NewClientBuilder().WithScheme(scheme).
+				WithStatusSubresource(&csiv1.NodeConnectivityPolicy{}).
+				WithObjects(
+					newNode("worker-1", nil),
+					newNode("worker-2", nil),
+					newNode("worker-3", nil),
+					newNode("worker-4", map[string]string{ioGroupLabel("1"): "true"}),
+					newNode("worker-5", map[string]string{"pool": "bare-metal"}),
+					policy,
+				).Build(),
+			Scheme:   scheme,
+			Recorder: record.NewFakeRecorder(10),
+		}
+		instance = hostdefiner.New(&csiv1.HostDefiner{Spec: csiv1.HostDefinerSpec{
+			IOGroupPlacement: &csiv1.IOGroupPlacement{
+				Strategy: csiv1.IOGroupPlacementRoundRobin,
+				IOGroups: []int{0, 1},
+			},
+		}})
+	})
+
+	It("should label the nodes before any host is defined", func() {
+		Expect(reconciler.reconcileNodeConnectivityPolicies(instance)).To(Succeed())
+		Expect(reconciler.reconcileIOGroupPlacement(instance)).To(Succeed())
+
+		Expect(getNodeLabels("worker-1")).To(HaveKeyWithValue(ioGroupLabel("0"), "true"))
+		Expect(getNodeLabels("worker-2")).To(HaveKeyWithValue(ioGroupLabel("0"), "true"))
+		Expect(getNodeLabels("worker-3")).To(HaveKeyWithValue(ioGroupLabel("1"), "true"))
+		Expect(getNodeLabels("worker-4")).To(Equal(map[string]string{ioGroupLabel("1"): "true"}))
+		Expect(getNodeLabels("worker-5")).To(HaveKeyWithValue(ioGroupLabel("3"), "true"))
+		Expect(instance.Status.IOGroupDistribution).To(Equal([]csiv1.IOGroupNodes{
+			{IOGroup: 0, Nodes: 2}, {IOGroup: 1, Nodes: 2}, {IOGroup: 3, Nodes: 1},
+		}))
+	})
+
+	It("should never move the placed nodes", func() {
+		Expect(reconciler.reconcileNodeConnectivityPolicies(instance)).To(Succeed())
+		Expect(reconciler.reconcileIOGroupPlacement(instance)).To(Succeed())
+
+		instance.Spec.IOGroupPlacement = &csiv1.IOGroupPlacement{
+			Strategy: csiv1.IOGroupPlacementFixed,
+			IOGroups: []int{2},
+		}
+		Expect(reconciler.reconcileIOGroupPlacement(instance)).To(Succeed())
+		Expect(getNodeLabels("worker-1")).NotTo(HaveKey(ioGroupLabel("2")))
+		Expect(reconciler.Create(context.TODO(), newNode("worker-6", nil))).To(Succeed())
+		Expect(reconciler.reconcileIOGroupPlacement(instance)).To(Succeed())
+		Expect(getNodeLabels("worker-6")).To(HaveKeyWithValue(ioGroupLabel("2"), "true"))
+	})
+
+	It("should place the nodes again when their policy stops applying", func() {
+		Expect(reconciler.reconcileNodeConnectivityPolicies(instance)).To(Succeed())
+		Expect(reconciler.reconcileIOGroupPlacement(instance)).To(Succeed())
+
+		node := &corev1.Node{}
+		Expect(reconciler.Get(context.TODO(), client.ObjectKey{Name: "worker-5"}, node)).To(Succeed())
+		node.Labels = map[string]string{"pool": "virtual"}
+		for key, value := range getNodeLabels("worker-5") {
+			if key != "pool" {
+				node.Labels[key] = value
+			}
+		}
+		Expect(reconciler.Update(context.TODO(), node)).To(Succeed())
+		Expect(reconciler.reconcileNodeConnectivityPolicies(instance)).To(Succeed())
+		Expect(reconciler.reconcileIOGroupPlacement(instance)).To(Succeed())
+
+		Expect(getNodeLabels("worker-5")).NotTo(HaveKey(ioGroupLabel("3")))
+		Expect(getNodeLabels("worker-5")).To(HaveKeyWithValue(ioGroupLabel("0"), "true"))
+		Expect(instance.Status.IOGroupDistribution).To(Equal([]csiv1.IOGroupNodes{
+			{IOGroup: 0, Nodes: 3}, {IOGroup: 1, Nodes: 2},
+		}))
+	})
+
+	It("should remove the labels it set when the HostDefiner is deleted", func() {
+		Expect(reconciler.reconcileNodeConnectivityPolicies(instance)).To(Succeed())
+		Expect(reconciler.reconcileIOGroupPlacement(instance)).To(Succeed())
+		Expect(reconciler.unlabelNodes()).To(Succeed())
+
+		Expect(getNodeLabels("worker-1")).To(BeEmpty())
+		Expect(getNodeLabels("worker-4")).To(Equal(map[string]string{ioGroupLabel("1"): "true"}))
+		Expect(getNodeLabels("worker-5")).To(Equal(map[string]string{"pool": "bare-metal"}))
+		nodes := &corev1.NodeList{}
+		Expect(reconciler.List(context.TODO(), nodes)).To(Succeed())
+		for _, node := range nodes.Items {
+			Expect(node.Annotations).To(BeEmpty())
+		}
+	})
+})
@@ -0,0 +1,159 @@
+/**
+ * Copyright 2025 IBM Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	discoveryv1 "k8s.io/api/discovery/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	storagev1 "k8s.io/api/storage/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+
+	"github.com/IBM/ibm-block-csi-operator/controllers/internal/common"
+	"github.com/IBM/ibm-block-csi-operator/controllers/internal/crutils"
+	"github.com/IBM/ibm-block-csi-operator/controllers/internal/hostdefiner"
+	oconfig "github.com/IBM/ibm-block-csi-operator/pkg/config"
+)
+
+// getArraySecretCIDRs returns the CIDRs of the management addresses in the Secrets of the storage classes
+// of the driver, it warns on the owner about the addresses which are not IP addresses.
+// The Secrets are read with the API reader, since they are not in the namespaces of the cache
+func getArraySecretCIDRs(c client.Client, apiReader client.Reader, recorder record.EventRecorder,
+	owner runtime.Object) ([]string, error) {
+	storageClasses := &storagev1.StorageClassList{}
+	if err := c.List(context.TODO(), storageClasses); err != nil {
+		return nil, err
+	}
+
+	var addresses []string
+	for i := range storageClasses.Items {
+		for _, secretKey := range common.GetStorageClassSecrets(&storageClasses.Items[i]) {
+			secret := &corev1.Secret{}
+			if err := apiReader.Get(context.TODO(), secretKey, secret); err != nil {
+				if errors.IsNotFound(err) {
+					continue
+				}
+				return nil, err
+			}
+			addresses = append(addresses, common.GetArrayManagementAddresses(secret)...)
+		}
+	}
+
+	cidrs, hostNames := common.GetAddressCIDRs(addresses)
+	if len(hostNames) > 0 {
+		recorder.Event(owner, corev1.EventTypeWarning, "ArrayAddressNotIP",
+			fmt.Sprintf("the management addresses %s are not IP addresses, add their CIDRs to networkPolicy.arrayCIDRs",
+				strings.Join(hostNames, ", ")))
+	}
+	return cidrs, nil
+}
+
+// getAPIServerDestination returns the egress destination of the endpoints of the Kubernetes API,
+// the EndpointSlices are read with the API reader, since they are not in the namespaces of the cache
+func getAPIServerDestination(apiReader client.Reader) (common.NetworkPolicyDestination, error) {
+	endpointSlices := &discoveryv1.EndpointSliceList{}
+	if err := apiReader.List(context.TODO(), endpointSlices, client.InNamespace(metav1.NamespaceDefault),
+		client.MatchingLabels{discoveryv1.LabelServiceName: "kubernetes"}); err != nil {
+		return common.NetworkPolicyDestination{}, err
+	}
+	return common.GetAPIServerDestination(endpointSlices.Items), nil
+}
+
+// applyNetworkPolicy applies the NetworkPolicy of the owner, or deletes the NetworkPolicy of the key
+// when networkPolicy is nil
+func applyNetworkPolicy(c client.Client, scheme *runtime.Scheme, owner metav1.Object,
+	key client.ObjectKey, networkPolicy *networkingv1.NetworkPolicy) error {
+	logger := log.WithValues("Resource Type", "NetworkPolicy", "Name", key.Name)
+
+	if networkPolicy == nil {
+		found := &networkingv1.NetworkPolicy{}
+		if err := c.Get(context.TODO(), key, found); err != nil {
+			if errors.IsNotFound(err) {
+				return nil
+			}
+			return err
+		}
+		logger.Info("deleting NetworkPolicy")
+		if err := c.Delete(context.TODO(), found); err != nil && !errors.IsNotFound(err) {
+			return err
+		}
+		return nil
+	}
+
+	if err := controllerutil.SetControllerReference(owner, networkPolicy, scheme); err != nil {
+		return err
+	}
+	if err := c.Patch(context.TODO(), networkPolicy, client.Apply,
+		client.FieldOwner(oconfig.FieldManager), client.ForceOwnership); err != nil {
+		logger.Error(err, "Failed to apply NetworkPolicy")
+		return err
+	}
+	return nil
+}
+
+// reconcileNetworkPolicy applies the NetworkPolicy of the controller pods when it is enabled, deletes it otherwise
+func (r *IBMBlockCSIReconciler) reconcileNetworkPolicy(instance *crutils.IBMBlockCSI) error {
+	if instance.IsNetworkPolicyEnabled() {
+		cidrs, err := getArraySecretCIDRs(r.Client, r.APIReader, r.Recorder, instance.Unwrap())
+		if err != nil {
+			return err
+		}
+		instance.ArraySecretCIDRs = cidrs
+		if instance.APIServerDestination, err = getAPIServerDestination(r.APIReader); err != nil {
+			return err
+		}
+	}
+	key := client.ObjectKey{
+		Name:      oconfig.GetNameForResource(oconfig.CSIControllerNetworkPolicy, instance.Name),
+		Namespace: instance.Namespace,
+	}
+	return applyNetworkPolicy(r.Client, r.Scheme, instance.Unwrap(), key, instance.GenerateControllerNetworkPolicy())
+}
+
+// reconcileNetworkPolicy applies the NetworkPolicy of the host definer pods when it is enabled, deletes it otherwise
+func (r *HostDefinerReconciler) reconcileNetworkPolicy(instance *hostdefiner.HostDefiner) error {
+	if instance.IsNetworkPolicyEnabled() {
+		cidrs, err := getArraySecretCIDRs(r.Client, r.APIReader, r.Recorder, instance.Unwrap())
+		if err != nil {
+			return err
+		}
+		instance.ArraySecretCIDRs = cidrs
+		if instance.APIServerDestination, err = getAPIServerDestination(r.APIReader); err != nil {
+			return err
+		}
+	}
+	key := client.ObjectKey{
+		Name:      oconfig.GetNameForResource(oconfig.HostDefinerNetworkPolicy, instance.Name),
+		Namespace: instance.Namespace,
+	}
+	return applyNetworkPolicy(r.Client, r.Scheme, instance.Unwrap(), key, instance.GenerateNetworkPolicy())
+}
+
+// isDriverStorageClass returns true if the object is a StorageClass of the driver
+func isDriverStorageClass(obj client.Object) bool {
+	storageClass, ok := obj.(*storagev1.StorageClass)
+	return ok && storageClass.Provisioner == oconfig.DriverName
+}
@@ -0,0 +1,129 @@
+/**
+ * Copyright 2025 IBM Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package controllers
+
+import (
+	"context"
+
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	csiv1 "github.com/IBM/ibm-block-csi-operator/api/v1"
+	"github.com/IBM/ibm-block-csi-operator/controllers/internal/common"
+	"github.com/IBM/ibm-block-csi-operator/controllers/internal/crutils"
+	"github.com/IBM/ibm-block-csi-operator/controllers/internal/hostdefiner"
+	oconfig "github.com/IBM/ibm-block-csi-operator/pkg/config"
+)
+
+// reconcileNodeConnectivityPolicies resolves the NodeConnectivityPolicy of each node, labels the node with its values
+// before the host definer defines the hosts of the node, sets them in the existing HostDefinitions of the node,
+// and sets the number of nodes each policy applies to in its status
+func (r *HostDefinerReconciler) reconcileNodeConnectivityPolicies(instance *hostdefiner.HostDefiner) error {
+	logger := hostDefinerLog.WithValues("Resource Type", "NodeConnectivityPolicy")
+
+	policies := &csiv1.NodeConnectivityPolicyList{}
+	if err := r.List(context.TODO(), policies); err != nil {
+		return err
+	}
+	statuses := map[string]*csiv1.NodeConnectivityPolicyStatus{}
+	var validPolicies []csiv1.NodeConnectivityPolicy
+	for i := range policies.Items {
+		status := &csiv1.NodeConnectivityPolicyStatus{}
+		if err := common.ValidateNodeConnectivityPolicy(&policies.Items[i]); err != nil {
+			status.Message = err.Error()
+		} else {
+			validPolicies = append(validPolicies, policies.Items[i])
+		}
+		statuses[policies.Items[i].Name] = status
+	}
+
+	nodes := &corev1.NodeList{}
+	if err := r.List(context.TODO(), nodes); err != nil {
+		return err
+	}
+	resolvedPolicies := map[string]*csiv1.NodeConnectivityPolicy{}
+	for i := range nodes.Items {
+		node := &nodes.Items[i]
+		policy := common.ResolveNodeConnectivityPolicy(validPolicies, node)
+		if policy != nil {
+			statuses[policy.Name].AppliedNodes++
+		}
+		resolvedPolicies[node.Name] = policy
+
+		patch := client.MergeFrom(node.DeepCopy())
+		if !common.SetNodeConnectivityPolicy(node, policy) {
+			continue
+		}
+		logger.Info("labelling node with its connectivity policy", "Name", node.Name,
+			"Policy", node.Annotations[oconfig.NodeConnectivityPolicyAnnotation])
+		if err := r.Patch(context.TODO(), node, patch); err != nil {
+			return err
+		}
+	}
+	instance.NodeConnectivityPolicies = resolvedPolicies
+
+	hostDefinitions := &csiv1.HostDefinitionList{}
+	if err := r.List(context.TODO(), hostDefinitions); err != nil {
+		return err
+	}
+	for i := range hostDefinitions.Items {
+		hostDefinition := &hostDefinitions.Items[i]
+		nodeName := hostDefinition.Spec.HostDefinition.NodeName
+		policy, found := resolvedPolicies[nodeName]
+		if !found {
+			continue
+		}
+		patch := client.MergeFrom(hostDefinition.DeepCopy())
+		if !common.SetHostDefinitionConnectivityPolicy(hostDefinition, policy) {
+			continue
+		}
+		logger.Info("setting the connectivity policy of HostDefinition", "HostDefinition", hostDefinition.Name,
+			"Node", nodeName, "Policy", hostDefinition.Annotations[oconfig.NodeConnectivityPolicyAnnotation])
+		if err := r.Patch(context.TODO(), hostDefinition, patch); err != nil {
+			return err
+		}
+	}
+
+	for i := range policies.Items {
+		policy := &policies.Items[i]
+		if status := statuses[policy.Name]; policy.Status != *status {
+			policy.Status = *status
+			if err := r.Status().Update(context.TODO(), policy); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// setPolicyConnectivityTypes sets the connectivity types of the valid NodeConnectivityPolicies on the instance,
+// so the node is prepared for them
+func (r *IBMBlockCSIReconciler) setPolicyConnectivityTypes(instance *crutils.IBMBlockCSI) error {
+	policies := &csiv1.NodeConnectivityPolicyList{}
+	if err := r.List(context.TODO(), policies); err != nil {
+		return err
+	}
+
+	for i := range policies.Items {
+		policy := &policies.Items[i]
+		if policy.Spec.ConnectivityType == "" || common.ValidateNodeConnectivityPolicy(policy) != nil {
+			continue
+		}
+		instance.PolicyConnectivityTypes = append(instance.PolicyConnectivityTypes, policy.Spec.ConnectivityType)
+	}
+	return nil
+}
@@ -0,0 +1,116 @@
+/**
+ * Copyright 2025 IBM Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package controllers
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	csiv1 "github.com/IBM/ibm-block-csi-operator/api/v1"
+	"github.com/IBM/ibm-block-csi-operator/controllers/internal/hostdefiner"
+	oconfig "github.com/IBM/ibm-block-csi-operator/pkg/config"
+)
+
+var _ = Describe("NodeConnectivityPolicy", func() {
+	var reconciler *HostDefinerReconciler
+	var instance *hostdefiner.HostDefiner
+	var policy *csiv1.NodeConnectivityPolicy
+
+	getNode := func() *corev1.Node {
+		node := &corev1.Node{}
+		Expect(reconciler.Get(context.TODO(), client.ObjectKey{Name: "worker-1"}, node)).To(Succeed())
+		return node
+	}
+	getHostDefinition := func() *csiv1.HostDefinition {
+		hostDefinition := &csiv1.HostDefinition{}
+		Expect(reconciler.Get(context.TODO(), client.ObjectKey{Name: "worker-1-array"}, hostDefinition)).To(Succeed())
+		return hostDefinition
+	}
+
+	BeforeEach(func() {
+		scheme := runtime.NewScheme()
+		Expect(clientgoscheme.AddToScheme(scheme)).To(Succeed())
+		Expect(csiv1.AddToScheme(scheme)).To(Succeed())
+		policy = &csiv1.NodeConnectivityPolicy{
+			ObjectMeta: metav1.ObjectMeta{Name: "bare-metal"},
+			Spec: csiv1.NodeConnectivityPolicySpec{
+				NodeSelector:              metav1.LabelSelector{MatchLabels: map[string]string{"pool": "bare-metal"}},
+				ConnectivityType:          csiv1.ConnectivityTypeFC,
+				PortSet:                   "portset1",
+				NodeNameOnStorageTemplate: "ocp-${node.name}",
+			},
+		}
+		reconciler = &HostDefinerReconciler{
+			Client: fake.NewClientBuilder().WithScheme(scheme).
+				WithStatusSubresource(&csiv1.NodeConnectivityPolicy{}).
+				WithObjects(
+					&corev1.Node{ObjectMeta: metav1.ObjectMeta{
+						Name:   "worker-1",
+						Labels: map[string]string{"pool": "bare-metal"},
+					}},
+					&csiv1.HostDefinition{
+						ObjectMeta: metav1.ObjectMeta{Name: "worker-1-array"},
+						Spec: csiv1.HostDefinitionSpec{HostDefinition: csiv1.Definition{
+							NodeName:         "worker-1",
+							ConnectivityType: string(csiv1.ConnectivityTypeISCSI),
+						}},
+					},
+					policy,
+				).Build(),
+			Scheme:   scheme,
+			Recorder: record.NewFakeRecorder(10),
+		}
+		instance = hostdefiner.New(&csiv1.HostDefiner{})
+	})
+
+	It("should label the node and set the HostDefinitions of the node with the policy", func() {
+		Expect(reconciler.reconcileNodeConnectivityPolicies(instance)).To(Succeed())
+
+		node := getNode()
+		Expect(node.Labels).To(HaveKeyWithValue(oconfig.HostDefinerConnectivityTypeLabel, "fc"))
+		Expect(node.Labels).To(HaveKeyWithValue(oconfig.HostDefinerPortSetLabel, "portset1"))
+		Expect(node.Annotations).To(HaveKeyWithValue(oconfig.HostDefinerNodeNameOnStorageAnnotation, "ocp-worker-1"))
+		definition := getHostDefinition().Spec.HostDefinition
+		Expect(definition.ConnectivityType).To(Equal("fc"))
+		Expect(definition.PortSet).To(Equal("portset1"))
+		Expect(definition.NodeNameOnStorage).To(Equal("ocp-worker-1"))
+		Expect(instance.NodeConnectivityPolicies).To(HaveKey("worker-1"))
+	})
+
+	It("should remove the values of a removed policy from the node and its HostDefinitions", func() {
+		Expect(reconciler.reconcileNodeConnectivityPolicies(instance)).To(Succeed())
+		Expect(reconciler.Delete(context.TODO(), policy)).To(Succeed())
+		Expect(reconciler.reconcileNodeConnectivityPolicies(instance)).To(Succeed())
+
+		node := getNode()
+		Expect(node.Labels).To(Equal(map[string]string{"pool": "bare-metal"}))
+		Expect(node.Annotations).To(BeEmpty())
+		hostDefinition := getHostDefinition()
+		Expect(hostDefinition.Annotations).To(BeEmpty())
+		Expect(hostDefinition.Spec.HostDefinition).To(Equal(csiv1.Definition{NodeName: "worker-1"}))
+		Expect(instance.NodeConnectivityPolicies["worker-1"]).To(BeNil())
+	})
+})
@@ -0,0 +1,131 @@
+/**
+ * Copyright 2025 IBM Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+
+	csiv1 "github.com/IBM/ibm-block-csi-operator/api/v1"
+	oconfig "github.com/IBM/ibm-block-csi-operator/pkg/config"
+)
+
+// serviceAccountNamespaceFile holds the namespace of the operator pod
+const serviceAccountNamespaceFile = "/var/run/secrets/kubernetes.io/serviceaccount/namespace"
+
+var operatorConditionGroupVersionKind = schema.GroupVersionKind{
+	Group:   oconfig.OperatorConditionApiGroup,
+	Version: oconfig.OperatorConditionVersion,
+	Kind:    oconfig.OperatorConditionKind,
+}
+
+// operatorConditionSpec is the part of the OperatorCondition spec the operator owns
+type operatorConditionSpec struct {
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+}
+
+// reconcileOperatorCondition publishes to OLM whether the operator is upgradeable,
+// which is when all the IBMBlockCSIs are. It does nothing when the operator is not installed by OLM.
+func (r *IBMBlockCSIReconciler) reconcileOperatorCondition() error {
+	logger := log.WithValues("Resource Type", oconfig.OperatorConditionKind)
+
+	operatorConditionName := os.Getenv(oconfig.ENVOperatorConditionName)
+	if operatorConditionName == "" {
+		return nil
+	}
+	available, err := r.ControllerHelper.IsAPIAvailable(operatorConditionGroupVersionKind)
+	if err != nil || !available {
+		return err
+	}
+	namespace, err := os.ReadFile(serviceAccountNamespaceFile)
+	if err != nil {
+		return fmt.Errorf("failed to get the operator namespace: %v", err)
+	}
+
+	upgradeable, err := r.getUpgradeableCondition()
+	if err != nil {
+		return err
+	}
+
+	operatorCondition := &unstructured.Unstructured{}
+	operatorCondition.SetGroupVersionKind(operatorConditionGroupVersionKind)
+	if err := r.Get(context.TODO(), types.NamespacedName{
+		Name:      operatorConditionName,
+		Namespace: strings.TrimSpace(string(namespace)),
+	}, operatorCondition); err != nil {
+		if errors.IsNotFound(err) {
+			return nil
+		}
+		return err
+	}
+
+	spec := operatorConditionSpec{}
+	if content, found := operatorCondition.Object["spec"].(map[string]interface{}); found {
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(content, &spec); err != nil {
+			return err
+		}
+	}
+	if !meta.SetStatusCondition(&spec.Conditions, upgradeable) {
+		return nil
+	}
+	content, err := runtime.DefaultUnstructuredConverter.ToUnstructured(&spec)
+	if err != nil {
+		return err
+	}
+	if err := unstructured.SetNestedField(operatorCondition.Object, content["conditions"], "spec", "conditions"); err != nil {
+		return err
+	}
+
+	logger.Info("updating OperatorCondition", "Name", operatorConditionName,
+		"Upgradeable", upgradeable.Status, "Message", upgradeable.Message)
+	return r.Update(context.TODO(), operatorCondition)
+}
+
+// getUpgradeableCondition returns the first Upgradeable condition of the IBMBlockCSIs which is not true
+func (r *IBMBlockCSIReconciler) getUpgradeableCondition() (metav1.Condition, error) {
+	upgradeable := metav1.Condition{
+		Type:    csiv1.ConditionUpgradeable,
+		Status:  metav1.ConditionTrue,
+		Reason:  "AsExpected",
+		Message: "the operator is upgradeable",
+	}
+
+	instances := &csiv1.IBMBlockCSIList{}
+	if err := r.List(context.TODO(), instances); err != nil {
+		return upgradeable, err
+	}
+	for _, instance := range instances.Items {
+		condition := meta.FindStatusCondition(instance.Status.Conditions, csiv1.ConditionUpgradeable)
+		if condition != nil && condition.Status != metav1.ConditionTrue {
+			upgradeable.Status = condition.Status
+			upgradeable.Reason = condition.Reason
+			upgradeable.Message = fmt.Sprintf("IBMBlockCSI %s/%s: %s", instance.Namespace, instance.Name, condition.Message)
+			break
+		}
+	}
+	return upgradeable, nil
+}
@@ -0,0 +1,68 @@
+/**
+ * Copyright 2025 IBM Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package controllers
+
+import (
+	"context"
+
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/IBM/ibm-block-csi-operator/controllers/internal/crutils"
+	oconfig "github.com/IBM/ibm-block-csi-operator/pkg/config"
+)
+
+// podSecurityLabels are the Pod Security admission labels of the namespace of the driver,
+// the node plugin pods need the privileged level
+var podSecurityLabels = map[string]string{
+	oconfig.PodSecurityEnforceLabel: oconfig.PodSecurityPrivileged,
+	oconfig.PodSecurityAuditLabel:   oconfig.PodSecurityPrivileged,
+	oconfig.PodSecurityWarnLabel:    oconfig.PodSecurityPrivileged,
+}
+
+// reconcilePodSecurityLabels labels the namespace of the IBMBlockCSI for Pod Security admission when
+// labelNamespaceForPodSecurity is set, so the namespace admits the privileged node plugin pods.
+// The labels the namespace already has are left as they are.
+func (r *IBMBlockCSIReconciler) reconcilePodSecurityLabels(instance *crutils.IBMBlockCSI) error {
+	if !instance.Spec.LabelNamespaceForPodSecurity {
+		return nil
+	}
+	logger := log.WithValues("Namespace", instance.Namespace)
+
+	namespace := &corev1.Namespace{}
+	if err := r.Get(context.TODO(), client.ObjectKey{Name: instance.Namespace}, namespace); err != nil {
+		return err
+	}
+
+	original := namespace.DeepCopy()
+	changed := false
+	for label, level := range podSecurityLabels {
+		if _, found := namespace.Labels[label]; !found {
+			if namespace.Labels == nil {
+				namespace.Labels = map[string]string{}
+			}
+			namespace.Labels[label] = level
+			changed = true
+		}
+	}
+	if !changed {
+		return nil
+	}
+
+	logger.Info("Labelling the namespace for Pod Security admission", "level", oconfig.PodSecurityPrivileged)
+	return r.Patch(context.TODO(), namespace, client.MergeFrom(original))
+}
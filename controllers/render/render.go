@@ -0,0 +1,259 @@
+/**
+ * Copyright 2025 IBM Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package render
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"io/ioutil"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
+	"sigs.k8s.io/yaml"
+
+	csiv1 "github.com/IBM/ibm-block-csi-operator/api/v1"
+	"github.com/IBM/ibm-block-csi-operator/controllers/internal/common"
+	"github.com/IBM/ibm-block-csi-operator/controllers/internal/crutils"
+	"github.com/IBM/ibm-block-csi-operator/controllers/internal/hostdefiner"
+	clustersyncer "github.com/IBM/ibm-block-csi-operator/controllers/syncer"
+	"github.com/IBM/ibm-block-csi-operator/pkg/config"
+)
+
+// CommandName is the name of the operator subcommand that renders manifests
+const CommandName = "render"
+
+const (
+	ibmBlockCSIKind = "IBMBlockCSI"
+	hostDefinerKind = "HostDefiner"
+
+	documentSeparator = "---\n"
+)
+
+var scheme = runtime.NewScheme()
+
+func init() {
+	utilruntime.Must(clientgoscheme.AddToScheme(scheme))
+	utilruntime.Must(csiv1.AddToScheme(scheme))
+}
+
+// Options holds the inputs of a render
+type Options struct {
+	// CrPath is the path of the IBMBlockCSI or HostDefiner custom resource YAML
+	CrPath string
+	// DefaultsPath is the path of the default custom resource YAML of the same kind,
+	// when empty the path is taken from the operator environment variables
+	DefaultsPath string
+	// ServerVersion is the Kubernetes version (major.minor) to render for
+	ServerVersion string
+	// TopologyEnabled renders the driver as if topology labels exist on the nodes
+	TopologyEnabled bool
+	// OpenShift renders the SecurityContextConstraints of the node and the ClusterRoles which use them
+	OpenShift bool
+}
+
+// Run parses the render subcommand arguments and writes the manifests to out
+func Run(args []string, out io.Writer) error {
+	options := Options{}
+	flags := flag.NewFlagSet(CommandName, flag.ContinueOnError)
+	flags.StringVar(&options.CrPath, "cr", "", "path of the IBMBlockCSI or HostDefiner custom resource YAML")
+	flags.StringVar(&options.DefaultsPath, "defaults", "",
+		fmt.Sprintf("path of the default custom resource YAML (defaults to $%s or $%s)",
+			config.EnvNameIBMBlockCSICrYaml, config.EnvNameHostDefinerCrYaml))
+	flags.StringVar(&options.ServerVersion, "kube-version", "", "Kubernetes version (major.minor) to render for")
+	flags.BoolVar(&options.TopologyEnabled, "topology", false, "render as if topology is in use in the cluster")
+	flags.BoolVar(&options.OpenShift, "openshift", false, "render as if the cluster is OpenShift")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+	if options.CrPath == "" {
+		return fmt.Errorf("the --cr flag is required")
+	}
+
+	return Render(options, out)
+}
+
+// Render writes all the manifests the operator generates for the custom resource as multi-document YAML
+func Render(options Options, out io.Writer) error {
+	crYaml, err := ioutil.ReadFile(options.CrPath)
+	if err != nil {
+		return fmt.Errorf("failed to read file %q: %v", options.CrPath, err)
+	}
+
+	typeMeta := &unstructured.Unstructured{}
+	if err := yaml.Unmarshal(crYaml, &typeMeta.Object); err != nil {
+		return fmt.Errorf("error unmarshaling yaml: %v", err)
+	}
+
+	var objects []client.Object
+	switch typeMeta.GetKind() {
+	case ibmBlockCSIKind:
+		objects, err = renderIBMBlockCSI(options, crYaml)
+	case hostDefinerKind:
+		objects, err = renderHostDefiner(options, crYaml)
+	default:
+		err = fmt.Errorf("unsupported kind %q, expected %s or %s", typeMeta.GetKind(), ibmBlockCSIKind, hostDefinerKind)
+	}
+	if err != nil {
+		return err
+	}
+
+	return writeObjects(objects, out)
+}
+
+func renderIBMBlockCSI(options Options, crYaml []byte) ([]client.Object, error) {
+	if err := loadDefaults(options.DefaultsPath, config.LoadDefaultsOfIBMBlockCSIFromFile,
+		config.LoadDefaultsOfIBMBlockCSI); err != nil {
+		return nil, err
+	}
+
+	cr := &csiv1.IBMBlockCSI{}
+	if err := yaml.Unmarshal(crYaml, cr); err != nil {
+		return nil, fmt.Errorf("error unmarshaling yaml: %v", err)
+	}
+	instance := crutils.New(cr, options.ServerVersion)
+	instance.SecurityContextConstraintsAvailable = options.OpenShift
+	instance.APIServerDestination = common.GetAPIServerDestination(nil)
+	scheme.Default(instance.Unwrap())
+	instance.SetDefaults()
+	if err := instance.Validate(); err != nil {
+		return nil, fmt.Errorf("wrong IBMBlockCSI options: %v", err)
+	}
+
+	clustersyncer.TopologyEnabled = options.TopologyEnabled
+	controller, err := clustersyncer.GenerateCSIControllerStatefulSet(instance)
+	if err != nil {
+		return nil, err
+	}
+	node, err := clustersyncer.GenerateCSINodeDaemonSet(instance)
+	if err != nil {
+		return nil, err
+	}
+
+	objects := []client.Object{
+		instance.GenerateCSIDriver(),
+		instance.GenerateControllerServiceAccount(),
+		instance.GenerateNodeServiceAccount(),
+	}
+	if trustedCABundleConfigMap := instance.GenerateTrustedCABundleConfigMap(); trustedCABundleConfigMap != nil {
+		objects = append(objects, trustedCABundleConfigMap)
+	}
+	for _, clusterRole := range instance.GenerateClusterRoles() {
+		objects = append(objects, clusterRole)
+	}
+	for _, clusterRoleBinding := range instance.GenerateClusterRoleBindings() {
+		objects = append(objects, clusterRoleBinding)
+	}
+	for _, machineConfig := range instance.GenerateMachineConfigs() {
+		objects = append(objects, machineConfig)
+	}
+	if instance.SecurityContextConstraintsAvailable {
+		scc, err := clustersyncer.GenerateCSINodeSecurityContextConstraints(instance)
+		if err != nil {
+			return nil, err
+		}
+		objects = append(objects, scc)
+	}
+	if networkPolicy := instance.GenerateControllerNetworkPolicy(); networkPolicy != nil {
+		objects = append(objects, networkPolicy)
+	}
+	return append(objects, controller, node), nil
+}
+
+func renderHostDefiner(options Options, crYaml []byte) ([]client.Object, error) {
+	if err := loadDefaults(options.DefaultsPath, config.LoadDefaultsOfHostDefinerFromFile,
+		config.LoadDefaultsOfHostDefiner); err != nil {
+		return nil, err
+	}
+
+	cr := &csiv1.HostDefiner{}
+	if err := yaml.Unmarshal(crYaml, cr); err != nil {
+		return nil, fmt.Errorf("error unmarshaling yaml: %v", err)
+	}
+	instance := hostdefiner.New(cr)
+	instance.APIServerDestination = common.GetAPIServerDestination(nil)
+	scheme.Default(instance.Unwrap())
+	instance.SetDefaults()
+
+	deployment, err := clustersyncer.GenerateHostDefinerDeployment(instance)
+	if err != nil {
+		return nil, err
+	}
+
+	objects := []client.Object{
+		instance.GenerateServiceAccount(),
+	}
+	if trustedCABundleConfigMap := instance.GenerateTrustedCABundleConfigMap(); trustedCABundleConfigMap != nil {
+		objects = append(objects, trustedCABundleConfigMap)
+	}
+	for _, clusterRole := range instance.GenerateClusterRoles() {
+		objects = append(objects, clusterRole)
+	}
+	for _, clusterRoleBinding := range instance.GenerateClusterRoleBindings() {
+		objects = append(objects, clusterRoleBinding)
+	}
+	if networkPolicy := instance.GenerateNetworkPolicy(); networkPolicy != nil {
+		objects = append(objects, networkPolicy)
+	}
+	return append(objects, deployment), nil
+}
+
+func loadDefaults(defaultsPath string, loadFromFile func(string) error, loadFromEnv func() error) error {
+	if defaultsPath != "" {
+		return loadFromFile(defaultsPath)
+	}
+	return loadFromEnv()
+}
+
+func writeObjects(objects []client.Object, out io.Writer) error {
+	for _, obj := range objects {
+		document, err := marshalObject(obj)
+		if err != nil {
+			return err
+		}
+		if _, err := io.WriteString(out, documentSeparator); err != nil {
+			return err
+		}
+		if _, err := out.Write(document); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func marshalObject(obj client.Object) ([]byte, error) {
+	gvk, err := apiutil.GVKForObject(obj, scheme)
+	if err != nil {
+		return nil, err
+	}
+
+	content, err := runtime.DefaultUnstructuredConverter.ToUnstructured(obj)
+	if err != nil {
+		return nil, err
+	}
+	rendered := &unstructured.Unstructured{Object: content}
+	rendered.SetGroupVersionKind(gvk)
+	unstructured.RemoveNestedField(rendered.Object, "metadata", "creationTimestamp")
+	unstructured.RemoveNestedField(rendered.Object, "spec", "template", "metadata", "creationTimestamp")
+	unstructured.RemoveNestedField(rendered.Object, "status")
+
+	return yaml.Marshal(rendered.Object)
+}
@@ -0,0 +1,216 @@
+/**
+ * Copyright 2025 IBM Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package render_test
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	. "github.com/IBM/ibm-block-csi-operator/controllers/render"
+)
+
+// set UPDATE_GOLDEN_FILES=true to regenerate the golden files after an intended change
+const updateGoldenFilesEnvVar = "UPDATE_GOLDEN_FILES"
+
+// the max workers flags depend on the number of CPUs of the machine running the tests
+var maxWorkersFlagsRegex = regexp.MustCompile(`(--worker-threads|--workers)=\d+`)
+
+var _ = Describe("Render", func() {
+	samplesDir := filepath.Join("..", "..", "config", "samples")
+
+	DescribeTable("should render the generated manifests of the sample custom resources",
+		func(crFileName string, goldenFileName string) {
+			crPath := filepath.Join(samplesDir, crFileName)
+			out := &bytes.Buffer{}
+
+			err := Render(Options{CrPath: crPath, DefaultsPath: crPath}, out)
+			Expect(err).NotTo(HaveOccurred())
+
+			rendered := maxWorkersFlagsRegex.ReplaceAll(out.Bytes(), []byte("$1=N"))
+			goldenPath := filepath.Join("testdata", goldenFileName)
+			if os.Getenv(updateGoldenFilesEnvVar) == "true" {
+				Expect(ioutil.WriteFile(goldenPath, rendered, 0644)).To(Succeed())
+			}
+			golden, err := ioutil.ReadFile(goldenPath)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(rendered)).To(Equal(string(golden)))
+		},
+		Entry("IBMBlockCSI", "csi.ibm.com_v1_ibmblockcsi_cr.yaml", "ibmblockcsi.golden.yaml"),
+		Entry("HostDefiner", "csi_v1_hostdefiner_cr.yaml", "hostdefiner.golden.yaml"),
+	)
+
+	It("should apply the pod template overrides", func() {
+		out := &bytes.Buffer{}
+		err := Render(Options{
+			CrPath:       filepath.Join("testdata", "ibmblockcsi_overrides.yaml"),
+			DefaultsPath: filepath.Join(samplesDir, "csi.ibm.com_v1_ibmblockcsi_cr.yaml"),
+		}, out)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(out.String()).To(ContainSubstring("example.com/override: \"true\""))
+		Expect(out.String()).To(ContainSubstring("name: EXTRA_ENV"))
+		Expect(out.String()).To(ContainSubstring("- --csi-endpoint=$(CSI_ENDPOINT)"))
+	})
+
+	It("should fail on overrides of protected fields", func() {
+		err := Render(Options{
+			CrPath:       filepath.Join("testdata", "ibmblockcsi_protected_overrides.yaml"),
+			DefaultsPath: filepath.Join(samplesDir, "csi.ibm.com_v1_ibmblockcsi_cr.yaml"),
+		}, &bytes.Buffer{})
+		Expect(err).To(MatchError(ContainSubstring("must stay privileged")))
+	})
+
+	It("should prepare the node for the connectivity types", func() {
+		out := &bytes.Buffer{}
+		err := Render(Options{
+			CrPath:       filepath.Join("testdata", "ibmblockcsi_nvme.yaml"),
+			DefaultsPath: filepath.Join(samplesDir, "csi.ibm.com_v1_ibmblockcsi_cr.yaml"),
+		}, out)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(out.String()).To(ContainSubstring("- nvme-tcp"))
+		Expect(out.String()).To(ContainSubstring("mountPath: /etc/nvme"))
+		Expect(out.String()).To(ContainSubstring("mountPath: /etc/iscsi"))
+	})
+
+	It("should render a MachineConfig per role of the host prerequisites", func() {
+		out := &bytes.Buffer{}
+		err := Render(Options{
+			CrPath:       filepath.Join("testdata", "ibmblockcsi_host_prerequisites.yaml"),
+			DefaultsPath: filepath.Join(samplesDir, "csi.ibm.com_v1_ibmblockcsi_cr.yaml"),
+		}, out)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(out.String()).To(ContainSubstring("name: 99-worker-ibm-block-csi-ibm-attach"))
+		Expect(out.String()).To(ContainSubstring("name: 99-master-ibm-block-csi-ibm-attach"))
+		Expect(out.String()).To(ContainSubstring("name: iscsid.service"))
+	})
+
+	It("should render the capacity tracking of the provisioner", func() {
+		out := &bytes.Buffer{}
+		err := Render(Options{
+			CrPath:       filepath.Join("testdata", "ibmblockcsi_capacity_tracking.yaml"),
+			DefaultsPath: filepath.Join(samplesDir, "csi.ibm.com_v1_ibmblockcsi_cr.yaml"),
+		}, out)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(out.String()).To(ContainSubstring("storageCapacity: true"))
+		Expect(out.String()).To(ContainSubstring("- --enable-capacity"))
+		Expect(out.String()).To(ContainSubstring("- --capacity-poll-interval=5m0s"))
+		Expect(out.String()).To(ContainSubstring("- csistoragecapacities"))
+	})
+
+	It("should render the enabled health monitor", func() {
+		out := &bytes.Buffer{}
+		err := Render(Options{
+			CrPath:       filepath.Join("testdata", "ibmblockcsi_health_monitor.yaml"),
+			DefaultsPath: filepath.Join(samplesDir, "csi.ibm.com_v1_ibmblockcsi_cr.yaml"),
+		}, out)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(out.String()).To(ContainSubstring(
+			"image: registry.k8s.io/sig-storage/csi-external-health-monitor-controller:v0.14.0"))
+		Expect(out.String()).To(ContainSubstring("- --enable-node-watcher=true"))
+	})
+
+	It("should render the call home of the controller plugin", func() {
+		out := &bytes.Buffer{}
+		err := Render(Options{
+			CrPath:       filepath.Join("testdata", "ibmblockcsi_call_home.yaml"),
+			DefaultsPath: filepath.Join(samplesDir, "csi.ibm.com_v1_ibmblockcsi_cr.yaml"),
+		}, out)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(out.String()).To(ContainSubstring("name: ENABLE_CALL_HOME\n          value: \"true\""))
+		Expect(out.String()).To(ContainSubstring("name: HTTPS_PROXY\n          value: http://proxy.example.com:3128"))
+		Expect(out.String()).To(ContainSubstring("name: NO_PROXY\n          value: .cluster.local"))
+	})
+
+	It("should render the trusted CA bundle of the controller and the node", func() {
+		out := &bytes.Buffer{}
+		err := Render(Options{
+			CrPath:       filepath.Join("testdata", "ibmblockcsi_trusted_ca.yaml"),
+			DefaultsPath: filepath.Join(samplesDir, "csi.ibm.com_v1_ibmblockcsi_cr.yaml"),
+		}, out)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(out.String()).To(ContainSubstring("config.openshift.io/inject-trusted-cabundle: \"true\""))
+		Expect(strings.Count(out.String(), "name: ibm-block-csi-trusted-ca-bundle")).To(Equal(3))
+		Expect(strings.Count(out.String(), "mountPath: /etc/ibm-block-csi-trusted-ca\n")).To(Equal(2))
+		Expect(strings.Count(out.String(), "mountPath: /etc/ibm-block-csi-trusted-ca-bundle\n")).To(Equal(4))
+		Expect(strings.Count(out.String(), "name: SSL_CERT_FILE\n          value: /etc/ibm-block-csi-trusted-ca-bundle/ca-bundle.crt")).To(Equal(2))
+		Expect(out.String()).To(ContainSubstring("cat /etc/ibm-block-csi-trusted-ca/ca-bundle.crt >> /etc/ibm-block-csi-trusted-ca-bundle/ca-bundle.crt"))
+		Expect(strings.Count(out.String(), "csi.ibm.com/trusted-ca-bundle-checksum")).To(Equal(2))
+	})
+
+	It("should apply the security context overrides of the controller", func() {
+		out := &bytes.Buffer{}
+		err := Render(Options{
+			CrPath:       filepath.Join("testdata", "ibmblockcsi_security_context.yaml"),
+			DefaultsPath: filepath.Join(samplesDir, "csi.ibm.com_v1_ibmblockcsi_cr.yaml"),
+		}, out)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(out.String()).To(ContainSubstring("runAsUser: 1000650000"))
+		Expect(out.String()).NotTo(ContainSubstring("runAsUser: 9999"))
+		Expect(out.String()).NotTo(ContainSubstring("readOnlyRootFilesystem: true"))
+		Expect(out.String()).NotTo(ContainSubstring("mountPath: /tmp"))
+	})
+
+	It("should render the SecurityContextConstraints of the node on OpenShift only", func() {
+		out := &bytes.Buffer{}
+		err := Render(Options{
+			CrPath:       filepath.Join(samplesDir, "csi.ibm.com_v1_ibmblockcsi_cr.yaml"),
+			DefaultsPath: filepath.Join(samplesDir, "csi.ibm.com_v1_ibmblockcsi_cr.yaml"),
+			OpenShift:    true,
+		}, out)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(out.String()).To(ContainSubstring("kind: SecurityContextConstraints"))
+		Expect(out.String()).To(ContainSubstring("name: ibm-block-csi-node\n"))
+		Expect(out.String()).To(ContainSubstring("name: ibm-block-csi-csi-node-scc-clusterrole"))
+		Expect(out.String()).To(ContainSubstring("resourceNames:\n  - ibm-block-csi-node\n"))
+		Expect(out.String()).To(MatchRegexp(`allowedCapabilities:\n- CHOWN\n- DAC_OVERRIDE\n- FOWNER\n- FSETID\n- SETGID\n- SETUID\n`))
+
+		out.Reset()
+		err = Render(Options{
+			CrPath:       filepath.Join(samplesDir, "csi.ibm.com_v1_ibmblockcsi_cr.yaml"),
+			DefaultsPath: filepath.Join(samplesDir, "csi.ibm.com_v1_ibmblockcsi_cr.yaml"),
+		}, out)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(out.String()).NotTo(ContainSubstring("securitycontextconstraints"))
+	})
+
+	It("should render the NetworkPolicy of the controller", func() {
+		out := &bytes.Buffer{}
+		err := Render(Options{
+			CrPath:       filepath.Join("testdata", "ibmblockcsi_network_policy.yaml"),
+			DefaultsPath: filepath.Join(samplesDir, "csi.ibm.com_v1_ibmblockcsi_cr.yaml"),
+		}, out)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(out.String()).To(ContainSubstring("kind: NetworkPolicy"))
+		Expect(out.String()).To(ContainSubstring("name: ibm-block-csi-controller-networkpolicy"))
+		Expect(out.String()).To(ContainSubstring("cidr: 10.0.0.0/24"))
+		Expect(out.String()).To(ContainSubstring("port: 9808"))
+		Expect(out.String()).To(ContainSubstring("k8s-app: kube-dns"))
+		Expect(out.String()).To(ContainSubstring("port: 6443"))
+	})
+
+	It("should fail on an unsupported kind", func() {
+		crPath := filepath.Join("..", "..", "config", "rbac", "role.yaml")
+		err := Render(Options{CrPath: crPath, DefaultsPath: crPath}, &bytes.Buffer{})
+		Expect(err).To(HaveOccurred())
+	})
+})
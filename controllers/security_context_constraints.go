@@ -0,0 +1,66 @@
+/**
+ * Copyright 2025 IBM Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package controllers
+
+import (
+	"context"
+
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/IBM/ibm-block-csi-operator/controllers/internal/crutils"
+	clustersyncer "github.com/IBM/ibm-block-csi-operator/controllers/syncer"
+	oconfig "github.com/IBM/ibm-block-csi-operator/pkg/config"
+)
+
+// reconcileNodeSecurityContextConstraints applies the SecurityContextConstraints of the node plugin pods on OpenShift,
+// the SecurityContextConstraints are cluster scoped, so they are deleted with the IBMBlockCSI by its finalizer
+func (r *IBMBlockCSIReconciler) reconcileNodeSecurityContextConstraints(instance *crutils.IBMBlockCSI) error {
+	if !instance.SecurityContextConstraintsAvailable {
+		return nil
+	}
+	logger := log.WithValues("Resource Type", crutils.SecurityContextConstraintsGroupVersionKind.Kind)
+
+	scc, err := clustersyncer.GenerateCSINodeSecurityContextConstraints(instance)
+	if err != nil {
+		return err
+	}
+	if err := r.Patch(context.TODO(), scc, client.Apply,
+		client.FieldOwner(oconfig.FieldManager), client.ForceOwnership); err != nil {
+		logger.Error(err, "Failed to apply SecurityContextConstraints", "Name", scc.GetName())
+		return err
+	}
+	return nil
+}
+
+func (r *IBMBlockCSIReconciler) deleteNodeSecurityContextConstraints(instance *crutils.IBMBlockCSI) error {
+	if !instance.SecurityContextConstraintsAvailable {
+		return nil
+	}
+	logger := log.WithName("deleteNodeSecurityContextConstraints")
+
+	scc := &unstructured.Unstructured{}
+	scc.SetGroupVersionKind(crutils.SecurityContextConstraintsGroupVersionKind)
+	scc.SetName(instance.GetCSINodeSCCName())
+	logger.Info("deleting SecurityContextConstraints", "Name", scc.GetName())
+	if err := r.Delete(context.TODO(), scc); err != nil && !errors.IsNotFound(err) {
+		logger.Error(err, "failed to delete SecurityContextConstraints", "Name", scc.GetName())
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,81 @@
+/**
+ * Copyright 2025 IBM Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package controllers
+
+import (
+	"context"
+	"time"
+
+	"sigs.k8s.io/controller-runtime/pkg/event"
+
+	csiv1 "github.com/IBM/ibm-block-csi-operator/api/v1"
+)
+
+// serverVersionPollInterval is the delay between checks of the Kubernetes version for a cluster upgrade
+const serverVersionPollInterval = 10 * time.Minute
+
+func (r *IBMBlockCSIReconciler) getKnownServerVersion() string {
+	r.serverVersionLock.RLock()
+	defer r.serverVersionLock.RUnlock()
+	return r.ServerVersion
+}
+
+func (r *IBMBlockCSIReconciler) setKnownServerVersion(serverVersion string) {
+	r.serverVersionLock.Lock()
+	defer r.serverVersionLock.Unlock()
+	r.ServerVersion = serverVersion
+}
+
+// watchServerVersion polls the Kubernetes version and reconciles all the IBMBlockCSIs when it changes,
+// so that the defaults of the new version are set after a cluster upgrade
+func (r *IBMBlockCSIReconciler) watchServerVersion(ctx context.Context, events chan<- event.GenericEvent) error {
+	ticker := time.NewTicker(serverVersionPollInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+
+		serverVersion, err := getServerVersion()
+		if err != nil {
+			log.Error(err, "failed to get the Kubernetes version")
+			continue
+		}
+		knownServerVersion := r.getKnownServerVersion()
+		if serverVersion == knownServerVersion {
+			continue
+		}
+
+		instances := &csiv1.IBMBlockCSIList{}
+		if err := r.List(ctx, instances); err != nil {
+			log.Error(err, "failed to list IBMBlockCSIs")
+			continue
+		}
+		log.Info("Kubernetes version changed", "From", knownServerVersion, "To", serverVersion)
+		r.setKnownServerVersion(serverVersion)
+		for i := range instances.Items {
+			select {
+			case events <- event.GenericEvent{Object: &instances.Items[i]}:
+			case <-ctx.Done():
+				return nil
+			}
+		}
+	}
+}
@@ -0,0 +1,68 @@
+/**
+ * Copyright 2025 IBM Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package controllers
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	csiv1 "github.com/IBM/ibm-block-csi-operator/api/v1"
+	"github.com/IBM/ibm-block-csi-operator/controllers/internal/crutils"
+)
+
+// newCustomResourceDefinitionMetadata returns the metadata of a CRD, to watch the CRDs
+// without the apiextensions types and without caching their schemas
+func newCustomResourceDefinitionMetadata() *metav1.PartialObjectMetadata {
+	return &metav1.PartialObjectMetadata{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: "apiextensions.k8s.io/v1",
+			Kind:       "CustomResourceDefinition",
+		},
+	}
+}
+
+// isSidecarCustomResourceDefinition returns true if the CRD is of an API of an optional sidecar
+func isSidecarCustomResourceDefinition(obj client.Object) bool {
+	for _, apis := range crutils.SidecarAPIs {
+		for _, api := range apis {
+			if api.GetCRDName() == obj.GetName() {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// setMissingSidecarAPIs discovers which APIs of the optional sidecars the cluster does not serve
+func (r *IBMBlockCSIReconciler) setMissingSidecarAPIs(instance *crutils.IBMBlockCSI) error {
+	instance.MissingSidecarAPIs = make(map[string][]string)
+	for name, apis := range crutils.SidecarAPIs {
+		if instance.GetSidecarEnabled(name) == csiv1.SidecarEnabledFalse {
+			continue
+		}
+		for _, api := range apis {
+			available, err := r.ControllerHelper.IsAPIAvailable(api.GroupVersionKind)
+			if err != nil {
+				return err
+			}
+			if !available {
+				instance.MissingSidecarAPIs[name] = append(instance.MissingSidecarAPIs[name], api.GetCRDName())
+			}
+		}
+	}
+	return nil
+}
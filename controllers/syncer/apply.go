@@ -0,0 +1,160 @@
+/**
+ * Copyright 2025 IBM Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package syncer
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/presslabs/controller-util/pkg/syncer"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/client-go/util/csaupgrade"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+
+	"github.com/IBM/ibm-block-csi-operator/pkg/config"
+)
+
+// applySyncer is a syncer.Interface which generates the full desired object
+// and applies it with server-side apply under the operator field manager
+type applySyncer struct {
+	name       string
+	owner      client.Object
+	obj        client.Object
+	client     client.Client
+	scheme     *runtime.Scheme
+	generateFn func() error
+}
+
+func newApplySyncer(name string, owner, obj client.Object, c client.Client, scheme *runtime.Scheme,
+	generateFn func() error) syncer.Interface {
+	return &applySyncer{
+		name:       name,
+		owner:      owner,
+		obj:        obj,
+		client:     c,
+		scheme:     scheme,
+		generateFn: generateFn,
+	}
+}
+
+// Object returns the applied object
+func (s *applySyncer) Object() interface{} {
+	return s.obj
+}
+
+// ObjectOwner returns the owner of the applied object
+func (s *applySyncer) ObjectOwner() runtime.Object {
+	return s.owner
+}
+
+// Sync applies the desired object and implements the syncer.Interface Sync method
+func (s *applySyncer) Sync(ctx context.Context) (syncer.SyncResult, error) {
+	result := syncer.SyncResult{}
+	log := logf.FromContext(ctx, "syncer", s.name)
+	key := client.ObjectKeyFromObject(s.obj)
+
+	operation, err := s.apply(ctx)
+	result.Operation = operation
+	kind := s.obj.GetObjectKind().GroupVersionKind().Kind
+	if err != nil {
+		result.SetEventData(corev1.EventTypeWarning, kind+"SyncFailed",
+			fmt.Sprintf("%s %s failed syncing: %s", kind, key, err))
+		log.Error(err, string(operation), "key", key, "kind", kind)
+	} else {
+		result.SetEventData(corev1.EventTypeNormal, kind+"SyncSuccessfull",
+			fmt.Sprintf("%s %s %s successfully", kind, key, operation))
+		log.V(1).Info(string(operation), "key", key, "kind", kind)
+	}
+
+	return result, err
+}
+
+func (s *applySyncer) apply(ctx context.Context) (controllerutil.OperationResult, error) {
+	if err := s.generateFn(); err != nil {
+		return controllerutil.OperationResultNone, err
+	}
+	if err := controllerutil.SetControllerReference(s.owner, s.obj, s.scheme); err != nil {
+		return controllerutil.OperationResultNone, err
+	}
+	gvk, err := apiutil.GVKForObject(s.obj, s.scheme)
+	if err != nil {
+		return controllerutil.OperationResultNone, err
+	}
+	s.obj.GetObjectKind().SetGroupVersionKind(gvk)
+
+	existing, err := s.getExisting(ctx)
+	if err != nil {
+		return controllerutil.OperationResultNone, err
+	}
+	if existing != nil {
+		if err := s.upgradeManagedFields(ctx, existing); err != nil {
+			return controllerutil.OperationResultNone, err
+		}
+	}
+
+	err = s.client.Patch(ctx, s.obj, client.Apply, client.FieldOwner(config.FieldManager), client.ForceOwnership)
+	if err != nil {
+		return controllerutil.OperationResultNone, err
+	}
+
+	if existing == nil {
+		return controllerutil.OperationResultCreated, nil
+	}
+	if existing.GetResourceVersion() != s.obj.GetResourceVersion() {
+		return controllerutil.OperationResultUpdated, nil
+	}
+	return controllerutil.OperationResultNone, nil
+}
+
+func (s *applySyncer) getExisting(ctx context.Context) (client.Object, error) {
+	newObj, err := s.scheme.New(s.obj.GetObjectKind().GroupVersionKind())
+	if err != nil {
+		return nil, err
+	}
+	existing := newObj.(client.Object)
+
+	err = s.client.Get(ctx, client.ObjectKeyFromObject(s.obj), existing)
+	if errors.IsNotFound(err) {
+		return nil, nil
+	}
+	return existing, err
+}
+
+// upgradeManagedFields moves the fields the operator owns from its former updates to the apply field manager,
+// so they are pruned once they are removed from the desired object.
+// It is done only before the first apply, fields updated later by the operator keep their own owner.
+func (s *applySyncer) upgradeManagedFields(ctx context.Context, existing client.Object) error {
+	for _, entry := range existing.GetManagedFields() {
+		if entry.Manager == config.FieldManager && entry.Operation == metav1.ManagedFieldsOperationApply {
+			return nil
+		}
+	}
+
+	patch, err := csaupgrade.UpgradeManagedFieldsPatch(existing, sets.New(config.Name), config.FieldManager)
+	if err != nil || patch == nil {
+		return err
+	}
+	return s.client.Patch(ctx, existing, client.RawPatch(types.JSONPatchType, patch))
+}
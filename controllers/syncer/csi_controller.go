@@ -22,7 +22,6 @@ import (
 	"strconv"
 	os "runtime"
 
-	"github.com/imdario/mergo"
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
@@ -34,14 +33,12 @@ import (
 	csiv1 "github.com/IBM/ibm-block-csi-operator/api/v1"
 	"github.com/IBM/ibm-block-csi-operator/controllers/internal/crutils"
 	"github.com/IBM/ibm-block-csi-operator/pkg/config"
-	"github.com/IBM/ibm-block-csi-operator/pkg/util/boolptr"
-	"github.com/presslabs/controller-util/pkg/mergo/transformers"
 	"github.com/presslabs/controller-util/pkg/syncer"
 )
 
 const (
 	socketVolumeName                     = "socket-dir"
-	ControllerContainerName              = "ibm-block-csi-controller"
+	ControllerContainerName              = config.CSIControllerContainerName
 	provisionerContainerName             = "csi-provisioner"
 	attacherContainerName                = "csi-attacher"
 	snapshotterContainerName             = "csi-snapshotter"
@@ -49,12 +46,13 @@ const (
 	replicatorContainerName              = "csi-addons-replicator"
 	volumeGroupContainerName             = "csi-volume-group"
 	controllerLivenessProbeContainerName = "livenessprobe"
+	healthMonitorContainerName           = "csi-external-health-monitor-controller"
 
 	commonMaxWorkersFlag  = "--worker-threads"
 	resizerMaxWorkersFlag = "--workers"
 
 	controllerContainerHealthPortName          = "healthz"
-	controllerContainerDefaultHealthPortNumber = 9808
+	controllerContainerDefaultHealthPortNumber = config.DefaultHealthPort
 )
 
 var TopologyEnabled = false
@@ -66,6 +64,34 @@ type csiControllerSyncer struct {
 
 // NewCSIControllerSyncer returns a syncer for CSI controller
 func NewCSIControllerSyncer(c client.Client, scheme *runtime.Scheme, driver *crutils.IBMBlockCSI) syncer.Interface {
+	obj := getStatefulSetSkeleton(driver)
+
+	sync := &csiControllerSyncer{
+		driver: driver,
+		obj:    obj,
+	}
+
+	return newApplySyncer(config.CSIController.String(), driver.Unwrap(), obj, c, scheme, func() error {
+		return sync.SyncFn()
+	})
+}
+
+// GenerateCSIControllerStatefulSet returns the CSI controller StatefulSet as the syncer would create it
+func GenerateCSIControllerStatefulSet(driver *crutils.IBMBlockCSI) (*appsv1.StatefulSet, error) {
+	obj := getStatefulSetSkeleton(driver)
+
+	sync := &csiControllerSyncer{
+		driver: driver,
+		obj:    obj,
+	}
+
+	if err := sync.SyncFn(); err != nil {
+		return nil, err
+	}
+	return obj, nil
+}
+
+func getStatefulSetSkeleton(driver *crutils.IBMBlockCSI) *appsv1.StatefulSet {
 	obj := &appsv1.StatefulSet{
 		ObjectMeta: metav1.ObjectMeta{
 			Name:        config.GetNameForResource(config.CSIController, driver.Name),
@@ -84,15 +110,7 @@ func NewCSIControllerSyncer(c client.Client, scheme *runtime.Scheme, driver *cru
 			},
 		},
 	}
-
-	sync := &csiControllerSyncer{
-		driver: driver,
-		obj:    obj,
-	}
-
-	return syncer.NewObjectSyncer(config.CSIController.String(), driver.Unwrap(), obj, c, func() error {
-		return sync.SyncFn()
-	})
+	return obj
 }
 
 func (s *csiControllerSyncer) SyncFn() error {
@@ -109,30 +127,41 @@ func (s *csiControllerSyncer) SyncFn() error {
 
 	out.ObjectMeta.Labels = controllerLabels
 	ensureAnnotations(&out.Spec.Template.ObjectMeta, &out.ObjectMeta, controllerAnnotations)
-
-	err := mergo.Merge(&out.Spec.Template.Spec, s.ensurePodSpec(), mergo.WithTransformers(transformers.PodSpec))
-	if err != nil {
-		return err
+	if s.driver.GetTrustedCAConfigMapName() != "" {
+		ensureTrustedCABundleChecksum(&out.Spec.Template.ObjectMeta, s.driver.TrustedCABundleChecksum)
 	}
 
-	return nil
+	podSpec := s.ensurePodSpec()
+	out.Spec.Template.Spec = *podSpec.DeepCopy()
+
+	return applyOverrides(&out.Spec.Template, s.driver.Spec.Controller.Overrides, podSpec, protectedPodFields{
+		volumeNames: []string{socketVolumeName},
+	})
 }
 
 func (s *csiControllerSyncer) ensurePodSpec() corev1.PodSpec {
-	fsGroup := config.ControllerUserID
+	controllerUserID := config.ControllerUserID
 	return corev1.PodSpec{
-		Containers: s.ensureContainersSpec(),
-		Volumes:    s.ensureVolumes(),
-		SecurityContext: &corev1.PodSecurityContext{
-			FSGroup:   &fsGroup,
-			RunAsUser: &fsGroup,
-		},
+		InitContainers:     s.ensureInitContainersSpec(),
+		Containers:         s.ensureContainersSpec(),
+		Volumes:            s.ensureVolumes(),
+		SecurityContext:    ensureRestrictedPodSecurityContext(&controllerUserID, s.driver.Spec.Controller.SecurityContext),
 		Affinity:           s.driver.Spec.Controller.Affinity,
 		Tolerations:        s.driver.Spec.Controller.Tolerations,
 		ServiceAccountName: config.GetNameForResource(config.CSIControllerServiceAccount, s.driver.Name),
 	}
 }
 
+func (s *csiControllerSyncer) ensureInitContainersSpec() []corev1.Container {
+	if s.driver.GetTrustedCAConfigMapName() == "" {
+		return nil
+	}
+	return []corev1.Container{
+		ensureTrustedCABundleContainer(s.driver.GetCSIControllerImage(), s.driver.Spec.Controller.ImagePullPolicy,
+			ensureRestrictedSecurityContext(s.driver.Spec.Controller.SecurityContext)),
+	}
+}
+
 func (s *csiControllerSyncer) ensureContainersSpec() []corev1.Container {
 	controllerPlugin := s.ensureContainer(ControllerContainerName,
 		s.driver.GetCSIControllerImage(),
@@ -173,6 +202,12 @@ func (s *csiControllerSyncer) ensureContainersSpec() []corev1.Container {
 	if TopologyEnabled {
 		provisionerArgs = append(provisionerArgs, "--feature-gates=Topology=true")
 	}
+	if s.driver.IsCapacityTrackingEnabled() {
+		provisionerArgs = append(provisionerArgs, "--enable-capacity", "--capacity-ownerref-level=1")
+		if pollInterval := s.driver.GetCapacityPollInterval(); pollInterval > 0 {
+			provisionerArgs = append(provisionerArgs, fmt.Sprintf("--capacity-poll-interval=%s", pollInterval))
+		}
+	}
 	provisioner := s.ensureContainer(provisionerContainerName,
 		s.getCSIProvisionerImage(),
 		provisionerArgs,
@@ -185,14 +220,18 @@ func (s *csiControllerSyncer) ensureContainersSpec() []corev1.Container {
 	)
 	attacher.ImagePullPolicy = s.getCSIAttacherPullPolicy()
 
+	snapshotterArgs := []string{
+		"--csi-address=$(ADDRESS)",
+		"--v=5",
+		"--timeout=120s",
+		maxWorkersFlag,
+	}
+	if s.driver.IsVolumeGroupSnapshotsEnabled() {
+		snapshotterArgs = append(snapshotterArgs, "--feature-gates=CSIVolumeGroupSnapshot=true")
+	}
 	snapshotter := s.ensureContainer(snapshotterContainerName,
 		s.getCSISnapshotterImage(),
-		[]string{
-			"--csi-address=$(ADDRESS)",
-			"--v=5",
-			"--timeout=120s",
-			maxWorkersFlag,
-		},
+		snapshotterArgs,
 	)
 	snapshotter.ImagePullPolicy = s.getCSISnapshotterPullPolicy()
 
@@ -228,6 +267,16 @@ func (s *csiControllerSyncer) ensureContainersSpec() []corev1.Container {
 		})
 	volumegroup.ImagePullPolicy = s.getCSIVolumeGroupPullPolicy()
 
+	healthMonitorArgs := []string{"--csi-address=$(ADDRESS)", "--v=5", "--timeout=30s"}
+	if s.driver.Spec.HealthMonitor != nil && s.driver.Spec.HealthMonitor.EnableNodeWatcher {
+		healthMonitorArgs = append(healthMonitorArgs, "--enable-node-watcher=true")
+	}
+	healthMonitor := s.ensureContainer(healthMonitorContainerName,
+		s.getCSIHealthMonitorControllerImage(),
+		healthMonitorArgs,
+	)
+	healthMonitor.ImagePullPolicy = s.getCSIHealthMonitorControllerPullPolicy()
+
 	healthPortArg := fmt.Sprintf("--health-port=%v", healthPort)
 	livenessProbe := s.ensureContainer(controllerLivenessProbeContainerName,
 		s.getLivenessProbeImage(),
@@ -238,16 +287,14 @@ func (s *csiControllerSyncer) ensureContainersSpec() []corev1.Container {
 	)
 	livenessProbe.ImagePullPolicy = s.getLivenessProbePullPolicy()
 
-	return []corev1.Container{
-		controllerPlugin,
-		provisioner,
-		attacher,
-		snapshotter,
-		resizer,
-		replicator,
-		volumegroup,
-		livenessProbe,
+	containers := []corev1.Container{controllerPlugin}
+	for _, sidecar := range []corev1.Container{provisioner, attacher, snapshotter, resizer, replicator, volumegroup,
+		healthMonitor} {
+		if s.driver.IsSidecarEnabled(sidecar.Name) {
+			containers = append(containers, sidecar)
+		}
 	}
+	return append(containers, livenessProbe)
 }
 
 func ensureDefaultResources() corev1.ResourceRequirements {
@@ -289,16 +336,14 @@ func ensureNodeAffinity() *corev1.NodeAffinity {
 }
 
 func (s *csiControllerSyncer) ensureContainer(name, image string, args []string) corev1.Container {
-	sc := &corev1.SecurityContext{AllowPrivilegeEscalation: boolptr.False()}
-	fillSecurityContextCapabilities(sc)
 	return corev1.Container{
 		Name:  name,
 		Image: image,
-		Args:  args,
+		Args:  append(args, s.driver.GetSidecarArgs(name)...),
 		//EnvFrom:         s.getEnvSourcesFor(name),
 		Env:             s.getEnvFor(name),
 		VolumeMounts:    s.getVolumeMountsFor(name),
-		SecurityContext: sc,
+		SecurityContext: ensureRestrictedSecurityContext(s.driver.Spec.Controller.SecurityContext),
 		Resources:       ensureDefaultResources(),
 	}
 }
@@ -323,7 +368,7 @@ func (s *csiControllerSyncer) getEnvFor(name string) []corev1.EnvVar {
 
 	switch name {
 	case ControllerContainerName:
-		return []corev1.EnvVar{
+		env := []corev1.EnvVar{
 			{
 				Name:  "CSI_ENDPOINT",
 				Value: config.CSIEndpoint,
@@ -334,21 +379,42 @@ func (s *csiControllerSyncer) getEnvFor(name string) []corev1.EnvVar {
 			},
 			{
 				Name:  "ENABLE_CALL_HOME",
-				Value: s.driver.Spec.EnableCallHome,
+				Value: strconv.FormatBool(s.driver.IsCallHomeEnabled()),
 			},
 			{
 				Name:  "ODF_VERSION_FOR_CALL_HOME",
 				Value: s.driver.Spec.ODFVersionForCallHome,
 			},
-			{
+		}
+		env = append(env, ensureProxyEnv(s.driver.GetCallHomeProxy())...)
+		if s.driver.GetTrustedCAConfigMapName() != "" {
+			env = append(env, ensureTrustedCAEnv()...)
+		}
+		return append(env,
+			corev1.EnvVar{
 				// TODO consider a different type of port. now uint16
 				Name:  "SVC_SSH_PORT",
 				Value: strconv.FormatUint(uint64(s.driver.Spec.SvcSshPort), 10),
 			},
+		)
+
+	case provisionerContainerName:
+		env := []corev1.EnvVar{
+			{
+				Name:  "ADDRESS",
+				Value: config.ControllerSocketPath,
+			},
 		}
+		if s.driver.IsCapacityTrackingEnabled() {
+			env = append(env,
+				envVarFromField("POD_NAME", "metadata.name"),
+				envVarFromField("NAMESPACE", "metadata.namespace"),
+			)
+		}
+		return env
 
-	case provisionerContainerName, attacherContainerName, snapshotterContainerName,
-		resizerContainerName, replicatorContainerName, volumeGroupContainerName:
+	case attacherContainerName, snapshotterContainerName, resizerContainerName,
+		replicatorContainerName, volumeGroupContainerName, healthMonitorContainerName:
 		return []corev1.EnvVar{
 			{
 				Name:  "ADDRESS",
@@ -361,8 +427,23 @@ func (s *csiControllerSyncer) getEnvFor(name string) []corev1.EnvVar {
 
 func (s *csiControllerSyncer) getVolumeMountsFor(name string) []corev1.VolumeMount {
 	switch name {
-	case ControllerContainerName, provisionerContainerName, attacherContainerName, snapshotterContainerName,
-		resizerContainerName, replicatorContainerName, volumeGroupContainerName:
+	case ControllerContainerName:
+		mounts := []corev1.VolumeMount{
+			{
+				Name:      socketVolumeName,
+				MountPath: config.ControllerSocketVolumeMountPath,
+			},
+		}
+		if s.driver.GetTrustedCAConfigMapName() != "" {
+			mounts = append(mounts, ensureTrustedCAVolumeMount())
+		}
+		if isReadOnlyRootFilesystem(s.driver.Spec.Controller.SecurityContext) {
+			mounts = append(mounts, ensureTmpVolumeMount())
+		}
+		return mounts
+
+	case provisionerContainerName, attacherContainerName, snapshotterContainerName, resizerContainerName,
+		replicatorContainerName, volumeGroupContainerName, healthMonitorContainerName:
 		return []corev1.VolumeMount{
 			{
 				Name:      socketVolumeName,
@@ -382,11 +463,18 @@ func (s *csiControllerSyncer) getVolumeMountsFor(name string) []corev1.VolumeMou
 }
 
 func (s *csiControllerSyncer) ensureVolumes() []corev1.Volume {
-	return []corev1.Volume{
+	volumes := []corev1.Volume{
 		ensureVolume(socketVolumeName, corev1.VolumeSource{
 			EmptyDir: &corev1.EmptyDirVolumeSource{},
 		}),
 	}
+	if trustedCA := s.driver.GetTrustedCAConfigMapName(); trustedCA != "" {
+		volumes = append(volumes, ensureTrustedCAVolumes(trustedCA)...)
+	}
+	if isReadOnlyRootFilesystem(s.driver.Spec.Controller.SecurityContext) {
+		volumes = append(volumes, ensureTmpVolume())
+	}
+	return volumes
 }
 
 func (s *csiControllerSyncer) getSidecarByName(name string) *csiv1.CSISidecar {
@@ -429,6 +517,10 @@ func (s *csiControllerSyncer) getCSIVolumeGroupImage() string {
 	return s.getSidecarImageByName(config.CSIVolumeGroup)
 }
 
+func (s *csiControllerSyncer) getCSIHealthMonitorControllerImage() string {
+	return s.getSidecarImageByName(config.CSIHealthMonitorController)
+}
+
 func (s *csiControllerSyncer) getSidecarPullPolicy(sidecarName string) corev1.PullPolicy {
 	sidecar := s.getSidecarByName(sidecarName)
 	if sidecar != nil && sidecar.ImagePullPolicy != "" {
@@ -465,6 +557,10 @@ func (s *csiControllerSyncer) getCSIVolumeGroupPullPolicy() corev1.PullPolicy {
 	return s.getSidecarPullPolicy(config.CSIVolumeGroup)
 }
 
+func (s *csiControllerSyncer) getCSIHealthMonitorControllerPullPolicy() corev1.PullPolicy {
+	return s.getSidecarPullPolicy(config.CSIHealthMonitorController)
+}
+
 func ensurePorts(ports ...corev1.ContainerPort) []corev1.ContainerPort {
 	return ports
 }
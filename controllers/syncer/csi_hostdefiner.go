@@ -21,9 +21,6 @@ import (
 
 	"github.com/IBM/ibm-block-csi-operator/controllers/internal/hostdefiner"
 	"github.com/IBM/ibm-block-csi-operator/pkg/config"
-	"github.com/IBM/ibm-block-csi-operator/pkg/util/boolptr"
-	"github.com/imdario/mergo"
-	"github.com/presslabs/controller-util/pkg/mergo/transformers"
 	"github.com/presslabs/controller-util/pkg/syncer"
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
@@ -33,7 +30,7 @@ import (
 )
 
 const (
-	HostDefinerContainerName = "ibm-block-csi-host-definer"
+	HostDefinerContainerName = config.HostDefinerContainerName
 )
 
 type hostDefinerSyncer struct {
@@ -49,11 +46,26 @@ func NewHostDefinerSyncer(c client.Client, scheme *runtime.Scheme, driver *hostd
 		obj:    obj,
 	}
 
-	return syncer.NewObjectSyncer(config.HostDefiner.String(), driver.Unwrap(), obj, c, func() error {
+	return newApplySyncer(config.HostDefiner.String(), driver.Unwrap(), obj, c, scheme, func() error {
 		return sync.SyncFn()
 	})
 }
 
+// GenerateHostDefinerDeployment returns the host definer Deployment as the syncer would create it
+func GenerateHostDefinerDeployment(driver *hostdefiner.HostDefiner) (*appsv1.Deployment, error) {
+	obj := getDeploymentSkeleton(driver)
+
+	sync := &hostDefinerSyncer{
+		driver: driver,
+		obj:    obj,
+	}
+
+	if err := sync.SyncFn(); err != nil {
+		return nil, err
+	}
+	return obj, nil
+}
+
 func getDeploymentSkeleton(driver *hostdefiner.HostDefiner) *appsv1.Deployment {
 	obj := &appsv1.Deployment{
 		ObjectMeta: metav1.ObjectMeta{
@@ -83,24 +95,64 @@ func (s *hostDefinerSyncer) SyncFn() error {
 	out.Spec.Template.ObjectMeta.Labels = labels
 	out.ObjectMeta.Labels = labels
 	ensureAnnotations(&out.Spec.Template.ObjectMeta, &out.ObjectMeta, s.driver.GetAnnotations())
-
-	err := mergo.Merge(&out.Spec.Template.Spec, s.ensurePodSpec(), mergo.WithTransformers(transformers.PodSpec))
-	if err != nil {
-		return err
+	if s.driver.GetTrustedCAConfigMapName() != "" {
+		ensureTrustedCABundleChecksum(&out.Spec.Template.ObjectMeta, s.driver.TrustedCABundleChecksum)
+	}
+	if s.driver.ArraySecretsChecksum != "" {
+		ensureArraySecretsChecksum(&out.Spec.Template.ObjectMeta, s.driver.ArraySecretsChecksum)
 	}
 
-	return nil
+	podSpec := s.ensurePodSpec()
+	out.Spec.Template.Spec = *podSpec.DeepCopy()
+
+	return applyOverrides(&out.Spec.Template, s.driver.Spec.HostDefiner.Overrides, podSpec, protectedPodFields{})
 }
 
 func (s *hostDefinerSyncer) ensurePodSpec() corev1.PodSpec {
+	hostDefinerUserID := config.HostDefinerUserID
 	return corev1.PodSpec{
+		InitContainers:     s.ensureInitContainersSpec(),
 		Containers:         s.ensureContainersSpec(),
+		Volumes:            s.ensureVolumes(),
+		SecurityContext:    ensureRestrictedPodSecurityContext(&hostDefinerUserID, s.driver.Spec.HostDefiner.SecurityContext),
 		Affinity:           s.driver.Spec.HostDefiner.Affinity,
 		Tolerations:        s.driver.Spec.HostDefiner.Tolerations,
 		ServiceAccountName: config.GetNameForResource(config.HostDefinerServiceAccount, s.driver.Name),
 	}
 }
 
+func (s *hostDefinerSyncer) ensureVolumes() []corev1.Volume {
+	var volumes []corev1.Volume
+	if trustedCA := s.driver.GetTrustedCAConfigMapName(); trustedCA != "" {
+		volumes = append(volumes, ensureTrustedCAVolumes(trustedCA)...)
+	}
+	if isReadOnlyRootFilesystem(s.driver.Spec.HostDefiner.SecurityContext) {
+		volumes = append(volumes, ensureTmpVolume())
+	}
+	return volumes
+}
+
+func (s *hostDefinerSyncer) getVolumeMounts() []corev1.VolumeMount {
+	var mounts []corev1.VolumeMount
+	if s.driver.GetTrustedCAConfigMapName() != "" {
+		mounts = append(mounts, ensureTrustedCAVolumeMount())
+	}
+	if isReadOnlyRootFilesystem(s.driver.Spec.HostDefiner.SecurityContext) {
+		mounts = append(mounts, ensureTmpVolumeMount())
+	}
+	return mounts
+}
+
+func (s *hostDefinerSyncer) ensureInitContainersSpec() []corev1.Container {
+	if s.driver.GetTrustedCAConfigMapName() == "" {
+		return nil
+	}
+	return []corev1.Container{
+		ensureTrustedCABundleContainer(s.driver.GetHostDefinerImage(), s.driver.Spec.HostDefiner.ImagePullPolicy,
+			ensureRestrictedSecurityContext(s.driver.Spec.HostDefiner.SecurityContext)),
+	}
+}
+
 func (s *hostDefinerSyncer) ensureContainersSpec() []corev1.Container {
 	hostDefinerPlugin := s.ensureContainer(HostDefinerContainerName,
 		s.driver.GetHostDefinerImage(),
@@ -117,20 +169,19 @@ func (s *hostDefinerSyncer) ensureContainersSpec() []corev1.Container {
 }
 
 func (s *hostDefinerSyncer) ensureContainer(name, image string, args []string) corev1.Container {
-	sc := &corev1.SecurityContext{AllowPrivilegeEscalation: boolptr.False()}
-	fillSecurityContextCapabilities(sc)
 	return corev1.Container{
 		Name:            name,
 		Image:           image,
 		Args:            args,
 		Env:             s.getEnv(),
-		SecurityContext: sc,
+		VolumeMounts:    s.getVolumeMounts(),
+		SecurityContext: ensureRestrictedSecurityContext(s.driver.Spec.HostDefiner.SecurityContext),
 		Resources:       ensureDefaultResources(),
 	}
 }
 
 func (s *hostDefinerSyncer) getEnv() []corev1.EnvVar {
-	return []corev1.EnvVar{
+	env := []corev1.EnvVar{
 		{
 			Name:  "PREFIX",
 			Value: s.driver.Spec.HostDefiner.Prefix,
@@ -152,4 +203,18 @@ func (s *hostDefinerSyncer) getEnv() []corev1.EnvVar {
                         Value: s.driver.Spec.HostDefiner.PortSet,
                 },
 	}
+	env = append(env, ensureProxyEnv(s.driver.ClusterProxy)...)
+	if s.driver.GetTrustedCAConfigMapName() != "" {
+		env = append(env, ensureTrustedCAEnv()...)
+	}
+	return env
+}
+
+// ensureArraySecretsChecksum annotates the pod template with the checksum of the array Secrets,
+// so the host definer resyncs the hosts when a Secret is rotated
+func ensureArraySecretsChecksum(templateObjectMeta *metav1.ObjectMeta, checksum string) {
+	if templateObjectMeta.Annotations == nil {
+		templateObjectMeta.Annotations = map[string]string{}
+	}
+	templateObjectMeta.Annotations[config.ArraySecretsChecksumAnnotation] = checksum
 }
@@ -19,7 +19,6 @@ package syncer
 import (
 	"fmt"
 
-	"github.com/imdario/mergo"
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -31,13 +30,16 @@ import (
 	"github.com/IBM/ibm-block-csi-operator/controllers/internal/crutils"
 	"github.com/IBM/ibm-block-csi-operator/pkg/config"
 	"github.com/IBM/ibm-block-csi-operator/pkg/util/boolptr"
-	"github.com/presslabs/controller-util/pkg/mergo/transformers"
 	"github.com/presslabs/controller-util/pkg/syncer"
 )
 
 const (
 	registrationVolumeName              = "registration-dir"
-	NodeContainerName                   = "ibm-block-csi-node"
+	iscsiVolumeName                     = "iscsi"
+	nvmeVolumeName                      = "nvme"
+	libModulesVolumeName                = "lib-modules"
+	NodeContainerName                   = config.CSINodeContainerName
+	kernelModulesContainerName          = "kernel-modules"
 	csiNodeDriverRegistrarContainerName = "csi-node-driver-registrar"
 	nodeLivenessProbeContainerName      = "livenessprobe"
 
@@ -55,6 +57,35 @@ type csiNodeSyncer struct {
 // NewCSINodeSyncer returns a syncer for CSI node
 func NewCSINodeSyncer(c client.Client, scheme *runtime.Scheme, driver *crutils.IBMBlockCSI,
 	daemonSetRestartedKey string, daemonSetRestartedValue string) syncer.Interface {
+	obj := getDaemonSetSkeleton(driver, daemonSetRestartedKey, daemonSetRestartedValue)
+
+	sync := &csiNodeSyncer{
+		driver: driver,
+		obj:    obj,
+	}
+
+	return newApplySyncer(config.CSINode.String(), driver.Unwrap(), obj, c, scheme, func() error {
+		return sync.SyncFn(daemonSetRestartedKey, daemonSetRestartedValue)
+	})
+}
+
+// GenerateCSINodeDaemonSet returns the CSI node DaemonSet as the syncer would create it
+func GenerateCSINodeDaemonSet(driver *crutils.IBMBlockCSI) (*appsv1.DaemonSet, error) {
+	obj := getDaemonSetSkeleton(driver, "", "")
+
+	sync := &csiNodeSyncer{
+		driver: driver,
+		obj:    obj,
+	}
+
+	if err := sync.SyncFn("", ""); err != nil {
+		return nil, err
+	}
+	return obj, nil
+}
+
+func getDaemonSetSkeleton(driver *crutils.IBMBlockCSI,
+	daemonSetRestartedKey string, daemonSetRestartedValue string) *appsv1.DaemonSet {
 	obj := &appsv1.DaemonSet{
 		ObjectMeta: metav1.ObjectMeta{
 			Name:        config.GetNameForResource(config.CSINode, driver.Name),
@@ -73,15 +104,7 @@ func NewCSINodeSyncer(c client.Client, scheme *runtime.Scheme, driver *crutils.I
 			},
 		},
 	}
-
-	sync := &csiNodeSyncer{
-		driver: driver,
-		obj:    obj,
-	}
-
-	return syncer.NewObjectSyncer(config.CSINode.String(), driver.Unwrap(), obj, c, func() error {
-		return sync.SyncFn(daemonSetRestartedKey, daemonSetRestartedValue)
-	})
+	return obj
 }
 
 func (s *csiNodeSyncer) SyncFn(daemonSetRestartedKey string, daemonSetRestartedValue string) error {
@@ -97,17 +120,22 @@ func (s *csiNodeSyncer) SyncFn(daemonSetRestartedKey string, daemonSetRestartedV
 
 	out.ObjectMeta.Labels = nodeLabels
 	ensureAnnotations(&out.Spec.Template.ObjectMeta, &out.ObjectMeta, nodeAnnotations)
-
-	err := mergo.Merge(&out.Spec.Template.Spec, s.ensurePodSpec(), mergo.WithTransformers(transformers.PodSpec))
-	if err != nil {
-		return err
+	if s.driver.GetTrustedCAConfigMapName() != "" {
+		ensureTrustedCABundleChecksum(&out.Spec.Template.ObjectMeta, s.driver.TrustedCABundleChecksum)
 	}
 
-	return nil
+	podSpec := s.ensurePodSpec()
+	out.Spec.Template.Spec = *podSpec.DeepCopy()
+
+	return applyOverrides(&out.Spec.Template, s.driver.Spec.Node.Overrides, podSpec, protectedPodFields{
+		volumeNames:              []string{socketVolumeName, registrationVolumeName},
+		privilegedContainerNames: []string{NodeContainerName},
+	})
 }
 
 func (s *csiNodeSyncer) ensurePodSpec() corev1.PodSpec {
 	return corev1.PodSpec{
+		InitContainers:     s.ensureInitContainersSpec(),
 		Containers:         s.ensureContainersSpec(),
 		Volumes:            s.ensureVolumes(),
 		HostIPC:            true,
@@ -118,6 +146,48 @@ func (s *csiNodeSyncer) ensurePodSpec() corev1.PodSpec {
 	}
 }
 
+func (s *csiNodeSyncer) ensureInitContainersSpec() []corev1.Container {
+	var initContainers []corev1.Container
+	if kernelModules := s.getKernelModules(); len(kernelModules) > 0 {
+		initContainers = append(initContainers, s.ensureKernelModulesLoaderContainer(kernelModules))
+	}
+	initContainers = append(initContainers, s.ensurePreflightContainer())
+	if s.driver.GetTrustedCAConfigMapName() != "" {
+		securityContext := &corev1.SecurityContext{AllowPrivilegeEscalation: boolptr.False()}
+		fillSecurityContextCapabilities(securityContext)
+		initContainers = append(initContainers, ensureTrustedCABundleContainer(s.driver.GetCSINodeImage(),
+			s.driver.Spec.Node.ImagePullPolicy, securityContext))
+	}
+	return initContainers
+}
+
+func (s *csiNodeSyncer) ensureKernelModulesLoaderContainer(kernelModules []string) corev1.Container {
+	// loads the kernel modules of the connectivity types on the host
+	kernelModulesLoader := s.ensureContainer(kernelModulesContainerName,
+		s.driver.GetCSINodeImage(),
+		kernelModules,
+	)
+	kernelModulesLoader.Command = []string{"modprobe", "-a"}
+	kernelModulesLoader.ImagePullPolicy = s.driver.Spec.Node.ImagePullPolicy
+	kernelModulesLoader.SecurityContext = &corev1.SecurityContext{AllowPrivilegeEscalation: boolptr.False()}
+	fillSecurityContextCapabilities(kernelModulesLoader.SecurityContext, "SYS_MODULE")
+	return kernelModulesLoader
+}
+
+func (s *csiNodeSyncer) getKernelModules() []string {
+	var kernelModules []string
+	if s.driver.IsNodeConnectivityTypeEnabled(csiv1.ConnectivityTypeNVMeOverFC, csiv1.ConnectivityTypeNVMeOverTCP) {
+		kernelModules = append(kernelModules, "nvme-fabrics")
+	}
+	if s.driver.IsNodeConnectivityTypeEnabled(csiv1.ConnectivityTypeNVMeOverFC) {
+		kernelModules = append(kernelModules, "nvme-fc")
+	}
+	if s.driver.IsNodeConnectivityTypeEnabled(csiv1.ConnectivityTypeNVMeOverTCP) {
+		kernelModules = append(kernelModules, "nvme-tcp")
+	}
+	return kernelModules
+}
+
 func (s *csiNodeSyncer) ensureContainersSpec() []corev1.Container {
 	// node plugin container
 	nodePlugin := s.ensureContainer(NodeContainerName,
@@ -210,7 +280,7 @@ func (s *csiNodeSyncer) ensureContainer(name, image string, args []string) corev
 	return corev1.Container{
 		Name:         name,
 		Image:        image,
-		Args:         args,
+		Args:         append(args, s.driver.GetSidecarArgs(name)...),
 		Env:          s.getEnvFor(name),
 		VolumeMounts: s.getVolumeMountsFor(name),
 		Resources:    ensureDefaultResources(),
@@ -234,7 +304,7 @@ func (s *csiNodeSyncer) getEnvFor(name string) []corev1.EnvVar {
 
 	switch name {
 	case NodeContainerName:
-		return []corev1.EnvVar{
+		env := []corev1.EnvVar{
 			{
 				Name:  "CSI_ENDPOINT",
 				Value: config.CSINodeEndpoint,
@@ -245,6 +315,11 @@ func (s *csiNodeSyncer) getEnvFor(name string) []corev1.EnvVar {
 			},
 			envVarFromField("KUBE_NODE_NAME", "spec.nodeName"),
 		}
+		env = append(env, ensureProxyEnv(s.driver.ClusterProxy)...)
+		if s.driver.GetTrustedCAConfigMapName() != "" {
+			env = append(env, ensureTrustedCAEnv()...)
+		}
+		return env
 
 	case csiNodeDriverRegistrarContainerName:
 		return []corev1.EnvVar{
@@ -266,7 +341,7 @@ func (s *csiNodeSyncer) getVolumeMountsFor(name string) []corev1.VolumeMount {
 
 	switch name {
 	case NodeContainerName:
-		return []corev1.VolumeMount{
+		volumeMounts := []corev1.VolumeMount{
 			{
 				Name:      socketVolumeName,
 				MountPath: config.NodeSocketVolumeMountPath,
@@ -290,10 +365,38 @@ func (s *csiNodeSyncer) getVolumeMountsFor(name string) []corev1.VolumeMount {
 				MountPropagation: &mountPropagationB,
 			},
 			{
-				Name:      "iscsi",
+				Name:      iscsiVolumeName,
 				MountPath: "/etc/iscsi",
 			},
 		}
+		if s.driver.IsNodeConnectivityTypeEnabled(csiv1.ConnectivityTypeNVMeOverFC, csiv1.ConnectivityTypeNVMeOverTCP) {
+			volumeMounts = append(volumeMounts, corev1.VolumeMount{
+				Name:      nvmeVolumeName,
+				MountPath: "/etc/nvme",
+			})
+		}
+		if s.driver.GetTrustedCAConfigMapName() != "" {
+			volumeMounts = append(volumeMounts, ensureTrustedCAVolumeMount())
+		}
+		return volumeMounts
+
+	case config.NodePreflightContainerName:
+		return []corev1.VolumeMount{
+			{
+				Name:      "host-dir",
+				MountPath: preflightHostMountPath,
+				ReadOnly:  true,
+			},
+		}
+
+	case kernelModulesContainerName:
+		return []corev1.VolumeMount{
+			{
+				Name:      libModulesVolumeName,
+				MountPath: "/lib/modules",
+				ReadOnly:  true,
+			},
+		}
 
 	case csiNodeDriverRegistrarContainerName:
 		return []corev1.VolumeMount{
@@ -319,15 +422,27 @@ func (s *csiNodeSyncer) getVolumeMountsFor(name string) []corev1.VolumeMount {
 }
 
 func (s *csiNodeSyncer) ensureVolumes() []corev1.Volume {
-	return []corev1.Volume{
+	volumes := []corev1.Volume{
 		ensureVolume("mountpoint-dir", ensureHostPathVolumeSource("/var/lib/kubelet/pods", "Directory")),
 		ensureVolume("socket-dir", ensureHostPathVolumeSource("/var/lib/kubelet/plugins/block.csi.ibm.com", "DirectoryOrCreate")),
 		ensureVolume("registration-dir", ensureHostPathVolumeSource("/var/lib/kubelet/plugins_registry", "Directory")),
 		ensureVolume("device-dir", ensureHostPathVolumeSource("/dev", "Directory")),
 		ensureVolume("sys-dir", ensureHostPathVolumeSource("/sys", "Directory")),
 		ensureVolume("host-dir", ensureHostPathVolumeSource("/", "Directory")),
-		ensureVolume("iscsi", ensureHostPathVolumeSource("/etc/iscsi", "Directory")),
+		ensureVolume(iscsiVolumeName, ensureHostPathVolumeSource("/etc/iscsi", "Directory")),
+	}
+	if s.driver.IsNodeConnectivityTypeEnabled(csiv1.ConnectivityTypeNVMeOverFC, csiv1.ConnectivityTypeNVMeOverTCP) {
+		volumes = append(volumes,
+			ensureVolume(nvmeVolumeName, ensureHostPathVolumeSource("/etc/nvme", "DirectoryOrCreate")))
+	}
+	if len(s.getKernelModules()) > 0 {
+		volumes = append(volumes,
+			ensureVolume(libModulesVolumeName, ensureHostPathVolumeSource("/lib/modules", "Directory")))
+	}
+	if trustedCA := s.driver.GetTrustedCAConfigMapName(); trustedCA != "" {
+		volumes = append(volumes, ensureTrustedCAVolumes(trustedCA)...)
 	}
+	return volumes
 }
 
 func (s *csiNodeSyncer) getSidecarByName(name string) *csiv1.CSISidecar {
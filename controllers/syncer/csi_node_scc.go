@@ -0,0 +1,121 @@
+/**
+ * Copyright 2025 IBM Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package syncer
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	"github.com/IBM/ibm-block-csi-operator/controllers/internal/crutils"
+	"github.com/IBM/ibm-block-csi-operator/pkg/util/boolptr"
+)
+
+const (
+	// projectedVolumeType is the volume type of the service account token, which the pods always mount
+	projectedVolumeType = "projected"
+
+	runAsAnyStrategy = "RunAsAny"
+)
+
+// GenerateCSINodeSecurityContextConstraints returns the SecurityContextConstraints of the node plugin pods,
+// which allow exactly the privileges of the generated pod template
+func GenerateCSINodeSecurityContextConstraints(driver *crutils.IBMBlockCSI) (*unstructured.Unstructured, error) {
+	daemonSet, err := GenerateCSINodeDaemonSet(driver)
+	if err != nil {
+		return nil, err
+	}
+	podSpec := daemonSet.Spec.Template.Spec
+
+	privileged := false
+	privilegeEscalation := false
+	capabilities := sets.NewString()
+	containers := append(append([]corev1.Container{}, podSpec.InitContainers...), podSpec.Containers...)
+	for _, container := range containers {
+		sc := container.SecurityContext
+		if sc == nil {
+			continue
+		}
+		privileged = privileged || boolptr.IsTrue(sc.Privileged)
+		privilegeEscalation = privilegeEscalation || boolptr.IsTrue(sc.AllowPrivilegeEscalation)
+		if sc.Capabilities != nil {
+			for _, capability := range sc.Capabilities.Add {
+				capabilities.Insert(string(capability))
+			}
+		}
+	}
+
+	hostPath := false
+	volumeTypes := sets.NewString(projectedVolumeType)
+	for _, volume := range podSpec.Volumes {
+		volumeType := getVolumeType(volume.VolumeSource)
+		volumeTypes.Insert(volumeType)
+		hostPath = hostPath || volume.HostPath != nil
+	}
+
+	scc := &unstructured.Unstructured{}
+	scc.SetGroupVersionKind(crutils.SecurityContextConstraintsGroupVersionKind)
+	scc.SetName(driver.GetCSINodeSCCName())
+	scc.SetLabels(driver.GetLabels())
+	scc.Object["allowPrivilegedContainer"] = privileged
+	scc.Object["allowPrivilegeEscalation"] = privilegeEscalation || privileged
+	scc.Object["allowHostNetwork"] = podSpec.HostNetwork
+	scc.Object["allowHostPorts"] = podSpec.HostNetwork
+	scc.Object["allowHostIPC"] = podSpec.HostIPC
+	scc.Object["allowHostPID"] = podSpec.HostPID
+	scc.Object["allowHostDirVolumePlugin"] = hostPath
+	scc.Object["allowedCapabilities"] = toInterfaces(capabilities.List())
+	scc.Object["volumes"] = toInterfaces(volumeTypes.List())
+	scc.Object["readOnlyRootFilesystem"] = false
+	for _, strategy := range []string{"runAsUser", "seLinuxContext", "fsGroup", "supplementalGroups"} {
+		scc.Object[strategy] = map[string]interface{}{"type": runAsAnyStrategy}
+	}
+	return scc, nil
+}
+
+// getVolumeType returns the SecurityContextConstraints volume type of a volume source
+func getVolumeType(source corev1.VolumeSource) string {
+	switch {
+	case source.HostPath != nil:
+		return "hostPath"
+	case source.EmptyDir != nil:
+		return "emptyDir"
+	case source.ConfigMap != nil:
+		return "configMap"
+	case source.Secret != nil:
+		return "secret"
+	case source.DownwardAPI != nil:
+		return "downwardAPI"
+	case source.Projected != nil:
+		return projectedVolumeType
+	case source.PersistentVolumeClaim != nil:
+		return "persistentVolumeClaim"
+	case source.CSI != nil:
+		return "csi"
+	case source.Ephemeral != nil:
+		return "ephemeral"
+	}
+	return "*"
+}
+
+func toInterfaces(values []string) []interface{} {
+	interfaces := make([]interface{}, 0, len(values))
+	for _, value := range values {
+		interfaces = append(interfaces, value)
+	}
+	return interfaces
+}
@@ -0,0 +1,84 @@
+/**
+ * Copyright 2025 IBM Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package syncer
+
+import (
+	"fmt"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+
+	csiv1 "github.com/IBM/ibm-block-csi-operator/api/v1"
+	"github.com/IBM/ibm-block-csi-operator/pkg/config"
+	"github.com/IBM/ibm-block-csi-operator/pkg/util/boolptr"
+)
+
+const preflightHostMountPath = "/host"
+
+// ensurePreflightContainer returns the init container which checks the host prerequisites of the
+// connectivity types. It never fails the pod, every failed check is a line of its termination message.
+func (s *csiNodeSyncer) ensurePreflightContainer() corev1.Container {
+	script := fmt.Sprintf("{\n%s\n} > %s\n", strings.Join(s.getPreflightChecks(), "\n"),
+		corev1.TerminationMessagePathDefault)
+
+	preflight := s.ensureContainer(config.NodePreflightContainerName,
+		s.driver.GetCSINodeImage(),
+		[]string{"-c", script},
+	)
+	preflight.Command = []string{"/bin/sh"}
+	preflight.ImagePullPolicy = s.driver.Spec.Node.ImagePullPolicy
+	preflight.TerminationMessagePolicy = corev1.TerminationMessageReadFile
+	preflight.SecurityContext = &corev1.SecurityContext{AllowPrivilegeEscalation: boolptr.False()}
+	fillSecurityContextCapabilities(preflight.SecurityContext)
+	return preflight
+}
+
+func (s *csiNodeSyncer) getPreflightChecks() []string {
+	checks := []string{
+		getHostFileCheck("/etc/multipath.conf"),
+		getHostProcessCheck("multipathd"),
+		fmt.Sprintf("ls %s/etc/udev/rules.d/99-ibm-*.rules >/dev/null 2>&1 || echo \"the IBM udev rules are missing\"",
+			preflightHostMountPath),
+	}
+	if s.driver.IsNodeConnectivityTypeEnabled(csiv1.ConnectivityTypeISCSI) {
+		checks = append(checks,
+			getHostFileCheck("/etc/iscsi/initiatorname.iscsi"),
+			getHostProcessCheck("iscsid"),
+		)
+	}
+	if s.driver.IsNodeConnectivityTypeEnabled(csiv1.ConnectivityTypeNVMeOverFC, csiv1.ConnectivityTypeNVMeOverTCP) {
+		checks = append(checks, getHostFileCheck("/etc/nvme/hostnqn"))
+	}
+	for _, kernelModule := range s.getKernelModules() {
+		checks = append(checks, getHostKernelModuleCheck(kernelModule))
+	}
+	return checks
+}
+
+func getHostFileCheck(path string) string {
+	return fmt.Sprintf("[ -f %s%s ] || echo \"%s is missing\"", preflightHostMountPath, path, path)
+}
+
+func getHostProcessCheck(name string) string {
+	return fmt.Sprintf("cat %s/proc/[0-9]*/comm 2>/dev/null | grep -qx %s || echo \"%s is not running\"",
+		preflightHostMountPath, name, name)
+}
+
+func getHostKernelModuleCheck(name string) string {
+	return fmt.Sprintf("[ -d %s/sys/module/%s ] || echo \"kernel module %s is not loaded\"",
+		preflightHostMountPath, strings.ReplaceAll(name, "-", "_"), name)
+}
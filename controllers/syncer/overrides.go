@@ -0,0 +1,129 @@
+/**
+ * Copyright 2025 IBM Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package syncer
+
+import (
+	"encoding/json"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/strategicpatch"
+
+	"github.com/IBM/ibm-block-csi-operator/controllers/util"
+	"github.com/IBM/ibm-block-csi-operator/pkg/util/boolptr"
+)
+
+// protectedPodFields lists what the overrides are not allowed to change in a generated pod spec.
+// The generated containers can never be removed.
+type protectedPodFields struct {
+	// volumeNames are the volumes which must keep their source and their mounts in the generated containers
+	volumeNames []string
+	// privilegedContainerNames are the containers which must stay privileged
+	privilegedContainerNames []string
+}
+
+// applyOverrides applies the overrides strategic merge patch on the pod template,
+// generated is the pod spec the syncer generated for the template
+func applyOverrides(template *corev1.PodTemplateSpec, overrides *runtime.RawExtension,
+	generated corev1.PodSpec, protected protectedPodFields) error {
+	if overrides == nil || len(overrides.Raw) == 0 {
+		return nil
+	}
+
+	original, err := json.Marshal(template)
+	if err != nil {
+		return err
+	}
+	patched, err := strategicpatch.StrategicMergePatch(original, overrides.Raw, corev1.PodTemplateSpec{})
+	if err != nil {
+		return fmt.Errorf("failed to apply overrides: %v", err)
+	}
+
+	result := corev1.PodTemplateSpec{}
+	if err := json.Unmarshal(patched, &result); err != nil {
+		return fmt.Errorf("failed to apply overrides: %v", err)
+	}
+	if err := protected.validate(generated, result.Spec); err != nil {
+		return fmt.Errorf("invalid overrides: %v", err)
+	}
+
+	*template = result
+	return nil
+}
+
+func (p protectedPodFields) validate(generated, patched corev1.PodSpec) error {
+	for _, generatedContainer := range generated.Containers {
+		patchedContainer := getContainerByName(patched.Containers, generatedContainer.Name)
+		if patchedContainer == nil {
+			return fmt.Errorf("container %s must not be removed", generatedContainer.Name)
+		}
+
+		for _, mount := range generatedContainer.VolumeMounts {
+			if !util.Contains(p.volumeNames, mount.Name) {
+				continue
+			}
+			if !containsVolumeMount(patchedContainer.VolumeMounts, mount) {
+				return fmt.Errorf("mount of volume %s in container %s must not be changed", mount.Name, generatedContainer.Name)
+			}
+		}
+
+		if util.Contains(p.privilegedContainerNames, generatedContainer.Name) &&
+			(patchedContainer.SecurityContext == nil || !boolptr.IsTrue(patchedContainer.SecurityContext.Privileged)) {
+			return fmt.Errorf("container %s must stay privileged", generatedContainer.Name)
+		}
+	}
+
+	for _, generatedVolume := range generated.Volumes {
+		if !util.Contains(p.volumeNames, generatedVolume.Name) {
+			continue
+		}
+		patchedVolume := getVolumeByName(patched.Volumes, generatedVolume.Name)
+		if patchedVolume == nil || !equality.Semantic.DeepEqual(generatedVolume.VolumeSource, patchedVolume.VolumeSource) {
+			return fmt.Errorf("volume %s must not be changed", generatedVolume.Name)
+		}
+	}
+	return nil
+}
+
+func getContainerByName(containers []corev1.Container, name string) *corev1.Container {
+	for i := range containers {
+		if containers[i].Name == name {
+			return &containers[i]
+		}
+	}
+	return nil
+}
+
+func getVolumeByName(volumes []corev1.Volume, name string) *corev1.Volume {
+	for i := range volumes {
+		if volumes[i].Name == name {
+			return &volumes[i]
+		}
+	}
+	return nil
+}
+
+func containsVolumeMount(mounts []corev1.VolumeMount, mount corev1.VolumeMount) bool {
+	for _, m := range mounts {
+		if m.Name == mount.Name && m.MountPath == mount.MountPath {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,85 @@
+/**
+ * Copyright 2025 IBM Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package syncer
+
+import (
+	corev1 "k8s.io/api/core/v1"
+
+	csiv1 "github.com/IBM/ibm-block-csi-operator/api/v1"
+	"github.com/IBM/ibm-block-csi-operator/pkg/util/boolptr"
+)
+
+const (
+	tmpVolumeName      = "tmp"
+	tmpVolumeMountPath = "/tmp"
+)
+
+// ensureRestrictedPodSecurityContext returns the pod security context of the Pod Security restricted profile,
+// defaultUser is the non-root user and the volumes group of the pods when the overrides set none
+func ensureRestrictedPodSecurityContext(defaultUser *int64, overrides *csiv1.SecurityContext) *corev1.PodSecurityContext {
+	sc := &corev1.PodSecurityContext{
+		RunAsNonRoot:   boolptr.True(),
+		RunAsUser:      defaultUser,
+		FSGroup:        defaultUser,
+		SeccompProfile: &corev1.SeccompProfile{Type: corev1.SeccompProfileTypeRuntimeDefault},
+	}
+	if overrides == nil {
+		return sc
+	}
+	if overrides.RunAsUser != nil {
+		sc.RunAsUser = overrides.RunAsUser
+	}
+	if overrides.RunAsGroup != nil {
+		sc.RunAsGroup = overrides.RunAsGroup
+	}
+	if overrides.FSGroup != nil {
+		sc.FSGroup = overrides.FSGroup
+	}
+	if overrides.SeccompProfile != nil {
+		sc.SeccompProfile = overrides.SeccompProfile
+	}
+	return sc
+}
+
+// ensureRestrictedSecurityContext returns the container security context of the Pod Security restricted profile
+func ensureRestrictedSecurityContext(overrides *csiv1.SecurityContext) *corev1.SecurityContext {
+	readOnlyRootFilesystem := isReadOnlyRootFilesystem(overrides)
+	sc := &corev1.SecurityContext{
+		AllowPrivilegeEscalation: boolptr.False(),
+		ReadOnlyRootFilesystem:   &readOnlyRootFilesystem,
+	}
+	fillSecurityContextCapabilities(sc)
+	return sc
+}
+
+func isReadOnlyRootFilesystem(overrides *csiv1.SecurityContext) bool {
+	return overrides == nil || overrides.ReadOnlyRootFilesystem == nil || *overrides.ReadOnlyRootFilesystem
+}
+
+// ensureTmpVolume returns the writable /tmp of the containers with a read-only root filesystem
+func ensureTmpVolume() corev1.Volume {
+	return ensureVolume(tmpVolumeName, corev1.VolumeSource{
+		EmptyDir: &corev1.EmptyDirVolumeSource{},
+	})
+}
+
+func ensureTmpVolumeMount() corev1.VolumeMount {
+	return corev1.VolumeMount{
+		Name:      tmpVolumeName,
+		MountPath: tmpVolumeMountPath,
+	}
+}
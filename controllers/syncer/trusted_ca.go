@@ -0,0 +1,131 @@
+/**
+ * Copyright 2025 IBM Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package syncer
+
+import (
+	"fmt"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	csiv1 "github.com/IBM/ibm-block-csi-operator/api/v1"
+	"github.com/IBM/ibm-block-csi-operator/pkg/config"
+)
+
+const (
+	trustedCAVolumeName          = "trusted-ca"
+	trustedCABundleVolumeName    = "trusted-ca-bundle"
+	trustedCABundleContainerName = "trusted-ca-bundle"
+)
+
+// systemCABundlePaths are the CA bundles of the RHEL and the Debian based images
+var systemCABundlePaths = []string{"/etc/pki/tls/certs/ca-bundle.crt", "/etc/ssl/certs/ca-certificates.crt"}
+
+// trustedCABundleScript writes the CA bundle of the image followed by the trusted CA bundle into the bundle
+// the containers trust, so they keep trusting the system CAs
+var trustedCABundleScript = fmt.Sprintf(
+	`for bundle in %s; do if [ -f "$bundle" ]; then cat "$bundle"; break; fi; done > %s && cat %s >> %s`,
+	strings.Join(systemCABundlePaths, " "), config.TrustedCABundlePath,
+	config.TrustedCAVolumeMountPath+"/"+config.TrustedCABundleKey, config.TrustedCABundlePath)
+
+// ensureProxyEnv returns the env vars of the proxy which are set, nil if there is no proxy
+func ensureProxyEnv(proxy *csiv1.ProxyConfig) []corev1.EnvVar {
+	if proxy == nil {
+		return nil
+	}
+
+	var env []corev1.EnvVar
+	for _, value := range []corev1.EnvVar{
+		{Name: config.ENVHTTPProxy, Value: proxy.HTTPProxy},
+		{Name: config.ENVHTTPSProxy, Value: proxy.HTTPSProxy},
+		{Name: config.ENVNoProxy, Value: proxy.NoProxy},
+	} {
+		if value.Value != "" {
+			env = append(env, value)
+		}
+	}
+	return env
+}
+
+// ensureTrustedCAEnv returns the env vars which point the TLS clients of a container to the trusted CA bundle
+func ensureTrustedCAEnv() []corev1.EnvVar {
+	return []corev1.EnvVar{
+		{Name: "SSL_CERT_FILE", Value: config.TrustedCABundlePath},
+		{Name: "REQUESTS_CA_BUNDLE", Value: config.TrustedCABundlePath},
+	}
+}
+
+// ensureTrustedCABundleContainer returns the init container which writes the bundle the containers trust
+func ensureTrustedCABundleContainer(image string, pullPolicy corev1.PullPolicy,
+	securityContext *corev1.SecurityContext) corev1.Container {
+	return corev1.Container{
+		Name:            trustedCABundleContainerName,
+		Image:           image,
+		ImagePullPolicy: pullPolicy,
+		Command:         []string{"/bin/sh"},
+		Args:            []string{"-c", trustedCABundleScript},
+		VolumeMounts: []corev1.VolumeMount{
+			{
+				Name:      trustedCAVolumeName,
+				MountPath: config.TrustedCAVolumeMountPath,
+				ReadOnly:  true,
+			},
+			{
+				Name:      trustedCABundleVolumeName,
+				MountPath: config.TrustedCABundleVolumeMountPath,
+			},
+		},
+		SecurityContext: securityContext,
+		Resources:       ensureDefaultResources(),
+	}
+}
+
+// ensureTrustedCAVolumes returns the volume of the trusted CA ConfigMap and the volume of the bundle
+// the containers trust
+func ensureTrustedCAVolumes(configMapName string) []corev1.Volume {
+	return []corev1.Volume{
+		ensureVolume(trustedCAVolumeName, corev1.VolumeSource{
+			ConfigMap: &corev1.ConfigMapVolumeSource{
+				LocalObjectReference: corev1.LocalObjectReference{
+					Name: configMapName,
+				},
+				Items: []corev1.KeyToPath{{Key: config.TrustedCABundleKey, Path: config.TrustedCABundleKey}},
+			},
+		}),
+		ensureVolume(trustedCABundleVolumeName, corev1.VolumeSource{
+			EmptyDir: &corev1.EmptyDirVolumeSource{},
+		}),
+	}
+}
+
+func ensureTrustedCAVolumeMount() corev1.VolumeMount {
+	return corev1.VolumeMount{
+		Name:      trustedCABundleVolumeName,
+		MountPath: config.TrustedCABundleVolumeMountPath,
+		ReadOnly:  true,
+	}
+}
+
+// ensureTrustedCABundleChecksum annotates the pod template with the trusted CA bundle checksum,
+// so the pods roll when the bundle changes
+func ensureTrustedCABundleChecksum(templateObjectMeta *metav1.ObjectMeta, checksum string) {
+	if templateObjectMeta.Annotations == nil {
+		templateObjectMeta.Annotations = map[string]string{}
+	}
+	templateObjectMeta.Annotations[config.TrustedCABundleChecksumAnnotation] = checksum
+}
@@ -0,0 +1,98 @@
+/**
+ * Copyright 2025 IBM Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package controllers
+
+import (
+	"context"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	csiv1 "github.com/IBM/ibm-block-csi-operator/api/v1"
+	"github.com/IBM/ibm-block-csi-operator/controllers/internal/common"
+	"github.com/IBM/ibm-block-csi-operator/controllers/internal/crutils"
+	"github.com/IBM/ibm-block-csi-operator/controllers/internal/hostdefiner"
+)
+
+// getTrustedCABundleChecksum returns the checksum of the CA bundle of the trusted CA ConfigMap,
+// empty if there is no trusted CA or the ConfigMap does not exist yet
+func getTrustedCABundleChecksum(c client.Client, configMapName, namespace string) (string, error) {
+	if configMapName == "" {
+		return "", nil
+	}
+	configMap, err := getConfigMapIfFound(c, configMapName, namespace)
+	if err != nil {
+		return "", err
+	}
+	return common.GetTrustedCABundleChecksum(configMap), nil
+}
+
+// reconcileTrustedCABundleConfigMap creates the ConfigMap OpenShift injects the trusted CA bundle of the cluster into
+func (r *IBMBlockCSIReconciler) reconcileTrustedCABundleConfigMap(instance *crutils.IBMBlockCSI) error {
+	configMap := instance.GenerateTrustedCABundleConfigMap()
+	if configMap == nil {
+		return nil
+	}
+	return createConfigMapIfNotFound(r.Client, r.Scheme, instance.Unwrap(), configMap)
+}
+
+// getIBMBlockCSIRequestsOfTrustedCA returns reconcile requests for the IBMBlockCSIs in the namespace
+// which trust the CA bundle of the ConfigMap
+func (r *IBMBlockCSIReconciler) getIBMBlockCSIRequestsOfTrustedCA(ctx context.Context, obj client.Object) []reconcile.Request {
+	ibmBlockCSIs := &csiv1.IBMBlockCSIList{}
+	if err := r.List(ctx, ibmBlockCSIs, client.InNamespace(obj.GetNamespace())); err != nil {
+		log.Error(err, "failed to list IBMBlockCSIs")
+		return nil
+	}
+
+	var requests []reconcile.Request
+	for i := range ibmBlockCSIs.Items {
+		instance := crutils.New(&ibmBlockCSIs.Items[i], "")
+		if instance.GetTrustedCAConfigMapName() == obj.GetName() {
+			requests = append(requests, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(instance.Unwrap())})
+		}
+	}
+	return requests
+}
+
+// reconcileTrustedCABundleConfigMap creates the ConfigMap OpenShift injects the trusted CA bundle of the cluster into
+func (r *HostDefinerReconciler) reconcileTrustedCABundleConfigMap(instance *hostdefiner.HostDefiner) error {
+	configMap := instance.GenerateTrustedCABundleConfigMap()
+	if configMap == nil {
+		return nil
+	}
+	return createConfigMapIfNotFound(r.Client, r.Scheme, instance.Unwrap(), configMap)
+}
+
+// getHostDefinerRequestsOfTrustedCA returns reconcile requests for the HostDefiners in the namespace
+// which trust the CA bundle of the ConfigMap
+func (r *HostDefinerReconciler) getHostDefinerRequestsOfTrustedCA(ctx context.Context, obj client.Object) []reconcile.Request {
+	hostDefiners := &csiv1.HostDefinerList{}
+	if err := r.List(ctx, hostDefiners, client.InNamespace(obj.GetNamespace())); err != nil {
+		hostDefinerLog.Error(err, "failed to list HostDefiners")
+		return nil
+	}
+
+	var requests []reconcile.Request
+	for i := range hostDefiners.Items {
+		instance := hostdefiner.New(&hostDefiners.Items[i])
+		if instance.GetTrustedCAConfigMapName() == obj.GetName() {
+			requests = append(requests, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(instance.Unwrap())})
+		}
+	}
+	return requests
+}
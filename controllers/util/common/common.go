@@ -29,6 +29,7 @@ import (
 	"k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
@@ -206,3 +207,15 @@ func (ch *ControllerHelper) getAccessorAndFinalizerName(instance crutils.Instanc
 	}
 	return accessor, finalizerName, nil
 }
+
+// IsAPIAvailable returns whether the cluster serves the kind, e.g. the OpenShift only kinds
+func (ch *ControllerHelper) IsAPIAvailable(gvk schema.GroupVersionKind) (bool, error) {
+	_, err := ch.RESTMapper().RESTMapping(gvk.GroupKind(), gvk.Version)
+	if meta.IsNoMatchError(err) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	return true, nil
+}
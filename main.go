@@ -23,8 +23,10 @@ import (
 	"os"
 	"strings"
 
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
+	"github.com/IBM/ibm-block-csi-operator/controllers/render"
 	"github.com/IBM/ibm-block-csi-operator/controllers/syncer"
 	"github.com/IBM/ibm-block-csi-operator/controllers/util/common"
 	kubeutil "github.com/IBM/ibm-block-csi-operator/pkg/util/kubernetes"
@@ -35,6 +37,7 @@ import (
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/log/zap"
 	"sigs.k8s.io/controller-runtime/pkg/cache"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	operatorConfig "github.com/IBM/ibm-block-csi-operator/pkg/config"
 
@@ -62,6 +65,14 @@ func init() {
 }
 
 func main() {
+	if len(os.Args) > 1 && os.Args[1] == render.CommandName {
+		if err := render.Run(os.Args[2:], os.Stdout); err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+		return
+	}
+
 	opts := zap.Options{
 		Development: true,
 	}
@@ -95,6 +106,12 @@ func main() {
 			DefaultNamespaces: map[string]cache.Config {
 				namespace: {},
 			},
+			ByObject: map[client.Object]cache.ByObject{
+				&corev1.Secret{}: {
+					Namespaces: map[string]cache.Config{cache.AllNamespaces: {}},
+					Label:      controllers.GetArraySecretSelector(),
+				},
+			},
 		},
 	})
 	if err != nil {
@@ -107,14 +124,18 @@ func main() {
 		Client:           mgr.GetClient(),
 		Scheme:           mgr.GetScheme(),
 		Namespace:        namespace,
+		Recorder:         mgr.GetEventRecorderFor(operatorConfig.Name),
+		APIReader:        mgr.GetAPIReader(),
 		ControllerHelper: controllerHelper,
 	}).SetupWithManager(mgr); err != nil {
 		setupLog.Error(err, "unable to create controller", "controller", "IBMBlockCSI")
 		os.Exit(1)
 	}
 	if err = (&controllers.HostDefinerReconciler{
-		Client: mgr.GetClient(),
-		Scheme: mgr.GetScheme(),
+		Client:   mgr.GetClient(),
+		Scheme:   mgr.GetScheme(),
+		Recorder:  mgr.GetEventRecorderFor(operatorConfig.Name),
+		APIReader: mgr.GetAPIReader(),
 	}).SetupWithManager(mgr); err != nil {
 		setupLog.Error(err, "unable to create controller", "controller", "HostDefiner")
 		os.Exit(1)
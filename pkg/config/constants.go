@@ -44,6 +44,58 @@ const (
 
 	ENVKubeVersion = "KUBE_VERSION"
 
+	MachineConfigApiGroup   = "machineconfiguration.openshift.io"
+	MachineConfigVersion    = "v1"
+	MachineConfigKind       = "MachineConfig"
+	MachineConfigRoleLabel  = MachineConfigApiGroup + "/role"
+	MachineConfigPriority   = "99"
+	IgnitionVersion         = "3.2.0"
+	MultipathConfigFilePath = "/etc/multipath.conf"
+	SCSITimeoutUdevRulePath = "/etc/udev/rules.d/99-ibm-2145.rules"
+	// MachineConfigNamespaceLabel is the namespace of the IBMBlockCSI of a MachineConfig,
+	// which tells apart the MachineConfigs of IBMBlockCSIs of the same name in different namespaces
+	MachineConfigNamespaceLabel = APIGroup + "/namespace"
+
+	SecurityContextConstraintsApiGroup = "security.openshift.io"
+	SecurityContextConstraintsVersion  = "v1"
+	SecurityContextConstraintsKind     = "SecurityContextConstraints"
+	// CSINodeSCCName is the name of the SecurityContextConstraints of the node plugin pods,
+	// it is fixed as the RBAC of the operator names it
+	CSINodeSCCName = "ibm-block-csi-node"
+
+	PauseReconcileAnnotation = APIGroup + "/pause-reconcile"
+
+	// ForceVersionAnnotation skips the version skew and downgrade checks of the driver versions
+	ForceVersionAnnotation = APIGroup + "/force-version"
+
+	// ENVOperatorConditionName is set by OLM to the name of the OperatorCondition of the operator
+	ENVOperatorConditionName  = "OPERATOR_CONDITION_NAME"
+	OperatorConditionApiGroup = "operators.coreos.com"
+	OperatorConditionVersion  = "v2"
+	OperatorConditionKind     = "OperatorCondition"
+
+	// ClusterProxyName is the name of the cluster-wide Proxy of OpenShift
+	ClusterProxyName     = "cluster"
+	ClusterProxyApiGroup = "config.openshift.io"
+	ClusterProxyVersion  = "v1"
+	ClusterProxyKind     = "Proxy"
+
+	// The proxy env vars of the operator, which OLM sets from the cluster-wide proxy
+	ENVHTTPProxy  = "HTTP_PROXY"
+	ENVHTTPSProxy = "HTTPS_PROXY"
+	ENVNoProxy    = "NO_PROXY"
+
+	// The Pod Security admission labels of the namespace of the driver,
+	// the node plugin pods are privileged
+	PodSecurityEnforceLabel = "pod-security.kubernetes.io/enforce"
+	PodSecurityAuditLabel   = "pod-security.kubernetes.io/audit"
+	PodSecurityWarnLabel    = "pod-security.kubernetes.io/warn"
+	PodSecurityPrivileged   = "privileged"
+
+	// FieldManager is the field manager of the workloads the operator applies with server-side apply,
+	// the fields the operator set before with updates are owned by the Name field manager
+	FieldManager = Name + "-apply"
+
 	CSINodeDriverRegistrar = "csi-node-driver-registrar"
 	CSIProvisioner         = "csi-provisioner"
 	CSIAttacher            = "csi-attacher"
@@ -53,6 +105,16 @@ const (
 	CSIVolumeGroup         = "csi-volume-group"
 	LivenessProbe          = "livenessprobe"
 
+	CSIHealthMonitorController = "csi-external-health-monitor-controller"
+
+	CSIControllerContainerName = "ibm-block-csi-controller"
+	CSINodeContainerName       = "ibm-block-csi-node"
+	HostDefinerContainerName   = "ibm-block-csi-host-definer"
+
+	// NodePreflightContainerName is the init container of the node plugin pods which checks the host prerequisites,
+	// its termination message lists the failed checks
+	NodePreflightContainerName = "preflight"
+
 	ControllerSocketVolumeMountPath                       = "/var/lib/csi/sockets/pluginproxy/"
 	NodeSocketVolumeMountPath                             = "/csi"
 	ControllerLivenessProbeContainerSocketVolumeMountPath = "/csi"
@@ -61,4 +123,66 @@ const (
 	NodeRegistrarSocketPath                               = "/var/lib/kubelet/plugins/block.csi.ibm.com/csi.sock"
 	CSIEndpoint                                           = "unix:///var/lib/csi/sockets/pluginproxy/csi.sock"
 	CSINodeEndpoint                                       = "unix:///csi/csi.sock"
+
+	// TrustedCABundleKey is the key of the CA bundle in a trusted CA ConfigMap
+	TrustedCABundleKey = "ca-bundle.crt"
+
+	// InjectTrustedCABundleLabel makes OpenShift inject the trusted CA bundle of the cluster into a ConfigMap
+	InjectTrustedCABundleLabel        = "config.openshift.io/inject-trusted-cabundle"
+	TrustedCAVolumeMountPath          = "/etc/ibm-block-csi-trusted-ca"
+	TrustedCABundleVolumeMountPath    = "/etc/ibm-block-csi-trusted-ca-bundle"
+	TrustedCABundlePath               = TrustedCABundleVolumeMountPath + "/" + TrustedCABundleKey
+	TrustedCABundleChecksumAnnotation = APIGroup + "/trusted-ca-bundle-checksum"
+
+	// ImagePullSecretSourceLabel labels the copies of the pull secrets of imagePullSecretSources
+	// with the namespace of their source
+	ImagePullSecretSourceLabel = APIGroup + "/image-pull-secret-source"
+	// ImagePullSecretShareableLabel opts a pull secret in to be copied by imagePullSecretSources
+	ImagePullSecretShareableLabel = APIGroup + "/image-pull-secret-shareable"
+	// ImagePullSecretsAnnotation lists the pull secrets the operator attached to a ServiceAccount,
+	// the other pull secrets of the ServiceAccount are kept
+	ImagePullSecretsAnnotation = APIGroup + "/image-pull-secrets"
+
+	// DefaultHealthPort is the health port of the controller and the node plugins when healthPort is not set
+	DefaultHealthPort = 9808
+
+	// The keys of a storage array Secret, config holds a JSON object of the arrays of a multi-array Secret
+	ArraySecretManagementAddressKey = "management_address"
+	ArraySecretConfigKey            = "config"
+	ArraySecretUsernameKey          = "username"
+	ArraySecretPasswordKey          = "password"
+	ArraySecretSSHPrivateKeyKey     = "ssh_private_key"
+
+	// ArraySecretLabel labels the storage array Secrets which the operator validates, of any namespace
+	ArraySecretLabel = DriverName + "/array-secret"
+	// ArraySecretVersionAnnotation and ArraySecretValidAnnotation annotate the HostDefinitions
+	// with the version and the validity of their array Secret
+	ArraySecretVersionAnnotation = APIGroup + "/array-secret-version"
+	ArraySecretValidAnnotation   = APIGroup + "/array-secret-valid"
+	// ArraySecretsChecksumAnnotation annotates the host definer pod template with the checksum of the versions
+	// of the array Secrets which HostDefinitions or StorageClasses reference,
+	// so the host definer resyncs the hosts when a Secret is rotated
+	ArraySecretsChecksumAnnotation = APIGroup + "/array-secrets-checksum"
+
+	// NodeConnectivityPolicyAnnotation annotates the nodes and their HostDefinitions with the name of the
+	// NodeConnectivityPolicy which applies to the node
+	NodeConnectivityPolicyAnnotation = APIGroup + "/node-connectivity-policy"
+	// NodeConnectivityPolicyFieldsAnnotation lists the fields the operator set from the NodeConnectivityPolicy
+	// of a node, which it clears when the policy does not set them anymore
+	NodeConnectivityPolicyFieldsAnnotation = APIGroup + "/node-connectivity-policy-fields"
+	// HostDefinerConnectivityTypeLabel labels a node with the connectivity type the host definer defines its hosts with
+	HostDefinerConnectivityTypeLabel = "hostdefiner.block.csi.ibm.com/connectivity-type"
+	// HostDefinerPortSetLabel labels a node with the port set the host definer defines its hosts with
+	HostDefinerPortSetLabel = "hostdefiner.block.csi.ibm.com/port-set"
+	// HostDefinerNodeNameOnStorageAnnotation annotates a node with the name the host definer defines its hosts with
+	HostDefinerNodeNameOnStorageAnnotation = "hostdefiner.block.csi.ibm.com/node-name-on-storage"
+	// HostDefinerIOGroupLabelPrefix labels a node with each IO group the host definer defines its hosts in,
+	// as <prefix><IO group>=true
+	HostDefinerIOGroupLabelPrefix = "hostdefiner.block.csi.ibm.com/io-group-"
+	// IOGroupsAnnotation lists the IO groups the operator labelled a node with,
+	// the other IO group labels of the node are kept
+	IOGroupsAnnotation = APIGroup + "/io-groups"
+	// IOGroupsPolicyAnnotation names the NodeConnectivityPolicy the IO groups of IOGroupsAnnotation come from,
+	// it is missing if they come from the IO group placement
+	IOGroupsPolicyAnnotation = APIGroup + "/io-groups-policy"
 )
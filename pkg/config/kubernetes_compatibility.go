@@ -0,0 +1,130 @@
+/**
+ * Copyright 2025 IBM Corp.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package config
+
+import (
+	"fmt"
+
+	utilversion "k8s.io/apimachinery/pkg/util/version"
+	"sigs.k8s.io/yaml"
+
+	v1 "github.com/IBM/ibm-block-csi-operator/api/v1"
+)
+
+// KubernetesCompatibility is an entry of the compatibility matrix of the default IBMBlockCSI custom resource.
+// It applies to the Kubernetes minor versions from MinVersion to MaxVersion, both are included and optional.
+type KubernetesCompatibility struct {
+	MinVersion string `json:"minVersion,omitempty"`
+	MaxVersion string `json:"maxVersion,omitempty"`
+
+	// Sidecars replace the default sidecars of the same name, or are added to them
+	Sidecars []v1.CSISidecar `json:"sidecars,omitempty"`
+
+	// SidecarArgs are added to the args of the sidecar containers of the same name
+	SidecarArgs map[string][]string `json:"sidecarArgs,omitempty"`
+
+	Features KubernetesFeatures `json:"features,omitempty"`
+}
+
+// KubernetesFeatures are the driver features which depend on the Kubernetes version
+type KubernetesFeatures struct {
+	// SELinuxMount sets seLinuxMount in the CSIDriver
+	SELinuxMount bool `json:"seLinuxMount,omitempty"`
+
+	// VolumeGroupSnapshots enables the volume group snapshots of the csi-snapshotter
+	VolumeGroupSnapshots bool `json:"volumeGroupSnapshots,omitempty"`
+}
+
+// ibmBlockCSIDefaults is the part of the default IBMBlockCSI custom resource file which is not a custom resource field
+type ibmBlockCSIDefaults struct {
+	KubernetesCompatibility []KubernetesCompatibility `json:"kubernetesCompatibility,omitempty"`
+}
+
+var DefaultKubernetesCompatibility []KubernetesCompatibility
+
+func loadKubernetesCompatibility(yamlFile []byte) error {
+	defaults := ibmBlockCSIDefaults{}
+	if err := yaml.Unmarshal(yamlFile, &defaults); err != nil {
+		return fmt.Errorf("error unmarshaling yaml: %v", err)
+	}
+
+	for _, compatibility := range defaults.KubernetesCompatibility {
+		for _, version := range []string{compatibility.MinVersion, compatibility.MaxVersion} {
+			if version == "" {
+				continue
+			}
+			if _, err := utilversion.ParseGeneric(version); err != nil {
+				return fmt.Errorf("wrong Kubernetes version %q in kubernetesCompatibility: %v", version, err)
+			}
+		}
+	}
+	DefaultKubernetesCompatibility = defaults.KubernetesCompatibility
+	return nil
+}
+
+// GetKubernetesCompatibility returns the first entry of the compatibility matrix which the
+// Kubernetes version (major.minor) matches, nil if none does or the version is unknown
+func GetKubernetesCompatibility(serverVersion string) *KubernetesCompatibility {
+	version, err := utilversion.ParseGeneric(serverVersion)
+	if err != nil {
+		return nil
+	}
+
+	for i := range DefaultKubernetesCompatibility {
+		compatibility := &DefaultKubernetesCompatibility[i]
+		if compatibility.MinVersion != "" &&
+			version.LessThan(utilversion.MustParseGeneric(compatibility.MinVersion)) {
+			continue
+		}
+		if compatibility.MaxVersion != "" &&
+			isNewerMinorVersion(version, utilversion.MustParseGeneric(compatibility.MaxVersion)) {
+			continue
+		}
+		return compatibility
+	}
+	return nil
+}
+
+// GetDefaultSidecars returns the default sidecars for the Kubernetes version
+func GetDefaultSidecars(serverVersion string) []v1.CSISidecar {
+	sidecars := append([]v1.CSISidecar{}, DefaultIBMBlockCSICr.Spec.Sidecars...)
+	compatibility := GetKubernetesCompatibility(serverVersion)
+	if compatibility == nil {
+		return sidecars
+	}
+
+	for _, compatibleSidecar := range compatibility.Sidecars {
+		replaced := false
+		for i := range sidecars {
+			if sidecars[i].Name == compatibleSidecar.Name {
+				sidecars[i] = compatibleSidecar
+				replaced = true
+			}
+		}
+		if !replaced {
+			sidecars = append(sidecars, compatibleSidecar)
+		}
+	}
+	return sidecars
+}
+
+func isNewerMinorVersion(version, maxVersion *utilversion.Version) bool {
+	if version.Major() != maxVersion.Major() {
+		return version.Major() > maxVersion.Major()
+	}
+	return version.Minor() > maxVersion.Minor()
+}
@@ -54,6 +54,15 @@ const (
 	CSINodeSCCClusterRoleBinding          ResourceName = "csi-node-scc-clusterrolebinding"
 	HostDefinerClusterRole                ResourceName = "hostdefiner-clusterrole"
 	HostDefinerClusterRoleBinding         ResourceName = "hostdefiner-clusterrolebinding"
+	CSIControllerNetworkPolicy            ResourceName = "controller-networkpolicy"
+	HostDefinerNetworkPolicy              ResourceName = "hostdefiner-networkpolicy"
+	HostPrerequisitesMachineConfig        ResourceName = "ibm-attach"
+
+	ExternalHealthMonitorClusterRole        ResourceName = "external-health-monitor-clusterrole"
+	ExternalHealthMonitorClusterRoleBinding ResourceName = "external-health-monitor-clusterrolebinding"
+
+	TrustedCABundleConfigMap            ResourceName = "trusted-ca-bundle"
+	HostDefinerTrustedCABundleConfigMap ResourceName = "hostdefiner-trusted-ca-bundle"
 )
 
 // GetNameForResource returns the name of a resource for a CSI driver
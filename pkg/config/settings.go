@@ -30,8 +30,9 @@ const (
 	EnvNameIBMBlockCSICrYaml = "IBMBlockCSI_CR_YAML"
 	EnvNameHostDefinerCrYaml = "HostDefiner_CR_YAML"
 
-	DefaultLogLevel  = "DEBUG"
-	ControllerUserID = int64(9999)
+	DefaultLogLevel   = "DEBUG"
+	ControllerUserID  = int64(9999)
+	HostDefinerUserID = int64(9999)
 
 	NodeAgentPort = "10086"
 
@@ -54,7 +55,16 @@ var OfficialRegistriesUsernames = sets.NewString(IBMRegistryUsername, K8SRegistr
 	RedHatRegistryUsername)
 
 func LoadDefaultsOfIBMBlockCSI() error {
-	yamlFile, err := getCrYamlFile(EnvNameIBMBlockCSICrYaml)
+	crYamlPath, err := getCrYamlPath(EnvNameIBMBlockCSICrYaml)
+	if err != nil {
+		return err
+	}
+	return LoadDefaultsOfIBMBlockCSIFromFile(crYamlPath)
+}
+
+// LoadDefaultsOfIBMBlockCSIFromFile loads the default IBMBlockCSI custom resource from the given path
+func LoadDefaultsOfIBMBlockCSIFromFile(crYamlPath string) error {
+	yamlFile, err := readCrYamlFile(crYamlPath)
 	if err != nil {
 		return err
 	}
@@ -70,11 +80,20 @@ func LoadDefaultsOfIBMBlockCSI() error {
 		DefaultSidecarsByName[sidecar.Name] = sidecar
 	}
 
-	return nil
+	return loadKubernetesCompatibility(yamlFile)
 }
 
 func LoadDefaultsOfHostDefiner() error {
-	yamlFile, err := getCrYamlFile(EnvNameHostDefinerCrYaml)
+	crYamlPath, err := getCrYamlPath(EnvNameHostDefinerCrYaml)
+	if err != nil {
+		return err
+	}
+	return LoadDefaultsOfHostDefinerFromFile(crYamlPath)
+}
+
+// LoadDefaultsOfHostDefinerFromFile loads the default HostDefiner custom resource from the given path
+func LoadDefaultsOfHostDefinerFromFile(crYamlPath string) error {
+	yamlFile, err := readCrYamlFile(crYamlPath)
 	if err != nil {
 		return err
 	}
@@ -87,15 +106,10 @@ func LoadDefaultsOfHostDefiner() error {
 	return nil
 }
 
-func getCrYamlFile(crPathEnvVariable string) ([]byte, error) {
-	crYamlPath, err := getCrYamlPath(crPathEnvVariable)
-	if err != nil {
-		return []byte{}, err
-	}
-
+func readCrYamlFile(crYamlPath string) ([]byte, error) {
 	yamlFile, err := ioutil.ReadFile(crYamlPath)
 	if err != nil {
-		return []byte{}, fmt.Errorf("failed to read file %q: %v", yamlFile, err)
+		return []byte{}, fmt.Errorf("failed to read file %q: %v", crYamlPath, err)
 	}
 	return yamlFile, nil
 }